@@ -0,0 +1,225 @@
+package proquint
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+)
+
+// AmbiguousError is returned by Abbreviator.Resolve, if an abbreviation
+// matches more than one ID.
+type AmbiguousError struct {
+	// Abbreviation is the ambiguous abbreviation.
+	Abbreviation string
+
+	// Candidates are all IDs matching the abbreviation.
+	Candidates []string
+}
+
+func (e *AmbiguousError) Error() string {
+	return fmt.Sprintf("abbreviation %q is ambiguous, candidates: %s", e.Abbreviation, strings.Join(e.Candidates, ", "))
+}
+
+type abbreviatorConfig struct {
+	minLength int
+	opts      []EncodingOption
+}
+
+type AbbreviatorOption func(*abbreviatorConfig)
+
+// WithMinLength sets the minimum length of an abbreviation in letters,
+// hyphens are not counted. The default is 5, a single syllable.
+func WithMinLength(letters int) AbbreviatorOption {
+	return func(cfg *abbreviatorConfig) {
+		cfg.minLength = letters
+	}
+}
+
+// WithAbbreviatorEncodingOptions sets the options used to encode the IDs.
+func WithAbbreviatorEncodingOptions(opts ...EncodingOption) AbbreviatorOption {
+	return func(cfg *abbreviatorConfig) {
+		cfg.opts = opts
+	}
+}
+
+// Abbreviator abbreviates proquint encoded IDs to the shortest prefix, which
+// is unique among a known set of IDs, similar to abbreviated commit hashes of
+// git. An Abbreviator is immutable and therefore safe for concurrent use.
+type Abbreviator struct {
+	minLength int
+
+	// letters contains the IDs without hyphens in lower case, sorted.
+	letters []string
+
+	// encoded maps the IDs without hyphens to the encoded IDs.
+	encoded map[string]string
+
+	// lengths maps the IDs without hyphens to the length of their shortest
+	// unique prefix.
+	lengths map[string]int
+}
+
+// NewAbbreviator returns an Abbreviator for the given IDs, each encoded with
+// FromBytes. Duplicate IDs are ignored.
+func NewAbbreviator(ids [][]byte, opts ...AbbreviatorOption) (*Abbreviator, error) {
+	cfg := newAbbreviatorConfig(opts)
+
+	quints := make([]string, 0, len(ids))
+	for _, id := range ids {
+		quint, err := FromBytes(id, cfg.opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		quints = append(quints, quint)
+	}
+
+	return newAbbreviator(quints, cfg)
+}
+
+// NewIntegerAbbreviator returns an Abbreviator for the given integer IDs,
+// each encoded with Encode. Duplicate IDs are ignored.
+func NewIntegerAbbreviator[T Integer](ids []T, opts ...AbbreviatorOption) (*Abbreviator, error) {
+	cfg := newAbbreviatorConfig(opts)
+
+	quints := make([]string, 0, len(ids))
+	for _, id := range ids {
+		quints = append(quints, Encode(id, cfg.opts...))
+	}
+
+	return newAbbreviator(quints, cfg)
+}
+
+func newAbbreviatorConfig(opts []AbbreviatorOption) abbreviatorConfig {
+	cfg := abbreviatorConfig{
+		minLength: 5,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	return cfg
+}
+
+func newAbbreviator(quints []string, cfg abbreviatorConfig) (*Abbreviator, error) {
+	if cfg.minLength < 1 {
+		return nil, fmt.Errorf("invalid minimum length %d", cfg.minLength)
+	}
+
+	a := &Abbreviator{
+		minLength: cfg.minLength,
+		letters:   make([]string, 0, len(quints)),
+		encoded:   make(map[string]string, len(quints)),
+		lengths:   make(map[string]int, len(quints)),
+	}
+
+	for _, quint := range quints {
+		letters := normalizeLetters(quint)
+		if _, ok := a.encoded[letters]; ok {
+			continue
+		}
+
+		a.letters = append(a.letters, letters)
+		a.encoded[letters] = quint
+	}
+
+	slices.Sort(a.letters)
+
+	// In a sorted list, the longest common prefix of an ID with any other
+	// ID is the common prefix with one of its neighbors.
+	for i, letters := range a.letters {
+		length := 0
+		if i > 0 {
+			length = max(length, commonPrefix(letters, a.letters[i-1]))
+		}
+
+		if i < len(a.letters)-1 {
+			length = max(length, commonPrefix(letters, a.letters[i+1]))
+		}
+
+		a.lengths[letters] = min(max(length+1, a.minLength), len(letters))
+	}
+
+	return a, nil
+}
+
+// Abbreviate returns the shortest unique prefix of the ID, which must be
+// one of the IDs of the Abbreviator. The ID may be given with or without
+// hyphens. The prefix keeps the hyphens of the encoded ID.
+func (a *Abbreviator) Abbreviate(id string) (string, error) {
+	letters := normalizeLetters(id)
+
+	length, ok := a.lengths[letters]
+	if !ok {
+		return "", fmt.Errorf("unknown id %q", id)
+	}
+
+	encoded := a.encoded[letters]
+
+	// Take the prefix of the encoded ID containing length letters.
+	end := 0
+	for count := 0; count < length; end++ {
+		if encoded[end] != '-' {
+			count++
+		}
+	}
+
+	return encoded[:end], nil
+}
+
+// Resolve returns the encoded ID starting with the abbreviation. An
+// abbreviation matching exactly one ID completely is never ambiguous and is
+// accepted even if it is shorter than the minimum length. If the
+// abbreviation matches more than one ID, an *AmbiguousError is returned.
+func (a *Abbreviator) Resolve(abbreviation string) (string, error) {
+	prefix := normalizeLetters(abbreviation)
+
+	// A complete ID is accepted even if it is shorter than the minimum
+	// length, Abbreviate never returns more than the complete ID.
+	if encoded, ok := a.encoded[prefix]; ok {
+		return encoded, nil
+	}
+
+	if len(prefix) < a.minLength {
+		return "", fmt.Errorf("abbreviation %q is shorter than %d letters", abbreviation, a.minLength)
+	}
+
+	start, _ := slices.BinarySearch(a.letters, prefix)
+
+	end := start
+	for end < len(a.letters) && strings.HasPrefix(a.letters[end], prefix) {
+		end++
+	}
+
+	switch end - start {
+	case 0:
+		return "", fmt.Errorf("no id matches abbreviation %q", abbreviation)
+	case 1:
+		return a.encoded[a.letters[start]], nil
+	default:
+		candidates := make([]string, 0, end-start)
+		for _, letters := range a.letters[start:end] {
+			candidates = append(candidates, a.encoded[letters])
+		}
+
+		return "", &AmbiguousError{
+			Abbreviation: abbreviation,
+			Candidates:   candidates,
+		}
+	}
+}
+
+// normalizeLetters returns the proquint in lower case without hyphens.
+func normalizeLetters(in string) string {
+	return strings.ToLower(strings.ReplaceAll(in, "-", ""))
+}
+
+func commonPrefix(a, b string) int {
+	i := 0
+	for i < len(a) && i < len(b) && a[i] == b[i] {
+		i++
+	}
+
+	return i
+}
@@ -0,0 +1,232 @@
+package proquint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+var abbreviatorIDs = []uint32{0x7F000001, 0x7F000002, 0x7F010000, 0xC0A80001, 0xC0A80001}
+
+func TestAbbreviatorAbbreviate(t *testing.T) {
+	tests := []struct {
+		name string
+		id   string
+		opts []proquint.AbbreviatorOption
+
+		assertErr require.ErrorAssertionFunc
+		want      string
+	}{
+		{
+			name: "shared prefix",
+			id:   "lusab-babad",
+
+			assertErr: require.NoError,
+			want:      "lusab-babad",
+		},
+		{
+			name: "minimum length",
+			id:   "safom-babad",
+
+			assertErr: require.NoError,
+			want:      "safom",
+		},
+		{
+			name: "shorter minimum length",
+			id:   "safom-babad",
+			opts: []proquint.AbbreviatorOption{proquint.WithMinLength(1)},
+
+			assertErr: require.NoError,
+			want:      "s",
+		},
+		{
+			name: "longer than minimum length",
+			id:   "lusad-babab",
+			opts: []proquint.AbbreviatorOption{proquint.WithMinLength(1)},
+
+			assertErr: require.NoError,
+			want:      "lusad",
+		},
+		{
+			name: "without hyphens",
+			id:   "LUSADBABAB",
+			opts: []proquint.AbbreviatorOption{proquint.WithMinLength(2)},
+
+			assertErr: require.NoError,
+			want:      "lusad",
+		},
+		{
+			name: "without hyphens encoded",
+			id:   "lusad-babab",
+			opts: []proquint.AbbreviatorOption{proquint.WithMinLength(7), proquint.WithAbbreviatorEncodingOptions()},
+
+			assertErr: require.NoError,
+			want:      "lusadba",
+		},
+		{
+			name: "unknown id",
+			id:   "zuzuz-zuzuz",
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := append([]proquint.AbbreviatorOption{proquint.WithAbbreviatorEncodingOptions(proquint.WithHyphens())}, test.opts...)
+
+			abbreviator, err := proquint.NewIntegerAbbreviator(abbreviatorIDs, opts...)
+			require.NoError(t, err)
+
+			got, err := abbreviator.Abbreviate(test.id)
+			test.assertErr(t, err)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestAbbreviatorResolve(t *testing.T) {
+	abbreviator, err := proquint.NewIntegerAbbreviator(abbreviatorIDs, proquint.WithAbbreviatorEncodingOptions(proquint.WithHyphens()))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name         string
+		abbreviation string
+
+		assertErr require.ErrorAssertionFunc
+		want      string
+	}{
+		{
+			name:         "unique",
+			abbreviation: "lusad",
+
+			assertErr: require.NoError,
+			want:      "lusad-babab",
+		},
+		{
+			name:         "upper case",
+			abbreviation: "SAFOMB",
+
+			assertErr: require.NoError,
+			want:      "safom-babad",
+		},
+		{
+			name:         "full id",
+			abbreviation: "lusabbabaf",
+
+			assertErr: require.NoError,
+			want:      "lusab-babaf",
+		},
+		{
+			name:         "ambiguous",
+			abbreviation: "lusab-ba",
+
+			assertErr: func(t require.TestingT, err error, _ ...any) {
+				var ambiguous *proquint.AmbiguousError
+				require.ErrorAs(t, err, &ambiguous)
+				require.Equal(t, []string{"lusab-babad", "lusab-babaf"}, ambiguous.Candidates)
+			},
+		},
+		{
+			name:         "too short",
+			abbreviation: "lusa",
+
+			assertErr: require.Error,
+		},
+		{
+			name:         "not found",
+			abbreviation: "zuzuz",
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := abbreviator.Resolve(test.abbreviation)
+			test.assertErr(t, err)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestAbbreviatorRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		ids  []uint16
+		opts []proquint.AbbreviatorOption
+	}{
+		{
+			name: "default",
+			ids:  []uint16{1, 2, 0x7F00},
+		},
+		{
+			name: "ids shorter than minimum length",
+			ids:  []uint16{1, 2},
+			opts: []proquint.AbbreviatorOption{proquint.WithMinLength(8)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			abbreviator, err := proquint.NewIntegerAbbreviator(test.ids, test.opts...)
+			require.NoError(t, err)
+
+			for _, id := range test.ids {
+				quint := proquint.FromUint16(id)
+
+				abbreviation, err := abbreviator.Abbreviate(quint)
+				require.NoError(t, err)
+
+				got, err := abbreviator.Resolve(abbreviation)
+				require.NoError(t, err)
+				require.Equal(t, quint, got)
+			}
+		})
+	}
+}
+
+func TestNewAbbreviator(t *testing.T) {
+	// The ID 0x7F00 is a prefix of the ID 0x7F000001.
+	abbreviator, err := proquint.NewAbbreviator([][]byte{{0x7F, 0x00}, {0x7F, 0x00, 0x00, 0x01}})
+	require.NoError(t, err)
+
+	got, err := abbreviator.Abbreviate("lusab")
+	require.NoError(t, err)
+	require.Equal(t, "lusab", got)
+
+	got, err = abbreviator.Resolve("lusab")
+	require.NoError(t, err)
+	require.Equal(t, "lusab", got)
+
+	got, err = abbreviator.Abbreviate("lusabbabad")
+	require.NoError(t, err)
+	require.Equal(t, "lusabb", got)
+
+	_, err = proquint.NewAbbreviator([][]byte{{0x7F}})
+	require.Error(t, err)
+
+	_, err = proquint.NewAbbreviator(nil, proquint.WithMinLength(0))
+	require.Error(t, err)
+}
+
+func ExampleAbbreviator() {
+	abbreviator, err := proquint.NewIntegerAbbreviator([]uint32{0x7F000001, 0x7F000002, 0xC0A80001}, proquint.WithAbbreviatorEncodingOptions(proquint.WithHyphens()))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, id := range []string{"lusab-babad", "lusab-babaf", "safom-babad"} {
+		abbreviation, _ := abbreviator.Abbreviate(id)
+		fmt.Println(abbreviation)
+	}
+	// Output:
+	// lusab-babad
+	// lusab-babaf
+	// safom
+}
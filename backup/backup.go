@@ -0,0 +1,321 @@
+// Package backup implements a paper backup format for secret keys, like
+// ed25519 seeds or age keys, based on proquints.
+//
+// A backup document consists of numbered lines. Line 0 is the header line
+// containing the format version, the length of the secret in bytes and a
+// checksum of the whole secret. The following lines contain the secret with
+// a fixed number of syllables per line. Each line ends with a check syllable,
+// which covers the line number and the data of the line:
+//
+//	0: babad-babab-babob-kidat-jafor norik
+//	1: nujod-rakit-vuzut-jonob-ropah-horuh lomof
+//	2: naros-fugah-hidan-sijon-losuf-kohin hupob
+//	3: labur-pubag-dufov-lutob tojud
+//
+// The final line may end with a half syllable, see
+// proquint.HalfSyllablePadding.
+package backup
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"math"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/breml/proquint"
+)
+
+// Version is the version of the backup format written by Encode.
+const Version = 1
+
+const headerSize = 2 + 4 + 4
+
+var (
+	// ErrVersion is returned by Decode, if the version of the document is not
+	// supported.
+	ErrVersion = errors.New("unsupported backup version")
+
+	// ErrLineChecksum is returned by Decode, if the check syllable of a line
+	// does not match.
+	ErrLineChecksum = errors.New("line checksum mismatch")
+
+	// ErrDocumentChecksum is returned by Decode, if the checksum in the header
+	// line does not match the decoded secret.
+	ErrDocumentChecksum = errors.New("document checksum mismatch")
+
+	// ErrMissingLine is returned by Decode, if a line is missing.
+	ErrMissingLine = errors.New("missing line")
+
+	// ErrDuplicateLine is returned by Decode, if a line number is used for
+	// lines with different content.
+	ErrDuplicateLine = errors.New("duplicate line")
+)
+
+// LineError is returned by Decode, if a line of the document is invalid.
+type LineError struct {
+	// Line is the line number as written in the document or -1, if the line
+	// number can not be read.
+	Line int
+
+	// Row is the position of the line in the input, starting at 1, or -1,
+	// if the error is not related to a single line of the input, e.g. for a
+	// missing line.
+	Row int
+
+	Err error
+}
+
+func (e *LineError) Error() string {
+	if e.Line < 0 {
+		return fmt.Sprintf("row %d: %v", e.Row, e.Err)
+	}
+
+	return fmt.Sprintf("line %d: %v", e.Line, e.Err)
+}
+
+func (e *LineError) Unwrap() error {
+	return e.Err
+}
+
+type config struct {
+	syllablesPerLine int
+}
+
+type Option func(*config)
+
+// WithSyllablesPerLine sets the number of data syllables per line, not
+// counting the check syllable. The default is 6.
+func WithSyllablesPerLine(n int) Option {
+	return func(cfg *config) {
+		cfg.syllablesPerLine = n
+	}
+}
+
+// Encode returns the backup document for the secret. Go strings can not be
+// wiped, so the caller should not keep the document longer than necessary.
+func Encode(secret []byte, opts ...Option) (string, error) {
+	cfg := config{
+		syllablesPerLine: 6,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.syllablesPerLine < 1 {
+		return "", fmt.Errorf("invalid number of %d syllables per line", cfg.syllablesPerLine)
+	}
+
+	if len(secret) == 0 || len(secret) > math.MaxUint32 {
+		return "", fmt.Errorf("invalid secret length of %d bytes", len(secret))
+	}
+
+	chunk := 2 * cfg.syllablesPerLine
+	lines := (len(secret) + chunk - 1) / chunk
+	if lines > math.MaxUint16 {
+		return "", fmt.Errorf("secret of %d bytes exceeds %d lines", len(secret), math.MaxUint16)
+	}
+
+	width := len(strconv.Itoa(lines))
+
+	var doc strings.Builder
+
+	header := make([]byte, 0, headerSize)
+	header = binary.BigEndian.AppendUint16(header, Version)
+	header = binary.BigEndian.AppendUint32(header, uint32(len(secret)))
+	header = append(header, checksum(secret)...)
+
+	err := writeLine(&doc, width, 0, header)
+	if err != nil {
+		return "", err
+	}
+
+	for i := 0; i < lines; i++ {
+		data := secret[i*chunk : min((i+1)*chunk, len(secret))]
+
+		err := writeLine(&doc, width, i+1, data)
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return doc.String(), nil
+}
+
+func writeLine(doc *strings.Builder, width int, number int, data []byte) error {
+	quints, err := proquint.FromBytes(data, proquint.WithHyphens(), proquint.WithEncodingPadding(proquint.HalfSyllablePadding))
+	if err != nil {
+		return err
+	}
+
+	fmt.Fprintf(doc, "%0*d: %s %s\n", width, number, quints, proquint.FromUint16(lineCheck(number, data)))
+
+	return nil
+}
+
+// Decode decodes a backup document created by Encode. The lines may be in
+// any order, empty lines are ignored. All intermediate buffers are wiped
+// before Decode returns. If a line is invalid, the returned error is a
+// *LineError.
+func Decode(doc string) ([]byte, error) {
+	var header []byte
+	defer func() { clear(header) }()
+
+	body := map[int][]byte{}
+	defer func() {
+		for _, data := range body {
+			clear(data)
+		}
+	}()
+
+	for i, text := range strings.Split(doc, "\n") {
+		if strings.TrimSpace(text) == "" {
+			continue
+		}
+
+		number, data, err := decodeLine(text)
+		if err != nil {
+			return nil, &LineError{Line: number, Row: i + 1, Err: err}
+		}
+
+		if number == 0 {
+			if header != nil && !bytes.Equal(header, data) {
+				clear(data)
+				return nil, &LineError{Line: number, Row: i + 1, Err: ErrDuplicateLine}
+			}
+
+			clear(header)
+			header = data
+
+			continue
+		}
+
+		if existing, ok := body[number]; ok {
+			if !bytes.Equal(existing, data) {
+				clear(data)
+				return nil, &LineError{Line: number, Row: i + 1, Err: ErrDuplicateLine}
+			}
+
+			clear(existing)
+		}
+
+		body[number] = data
+	}
+
+	if header == nil {
+		return nil, &LineError{Line: 0, Row: -1, Err: ErrMissingLine}
+	}
+
+	if len(header) != headerSize {
+		return nil, &LineError{Line: 0, Row: -1, Err: fmt.Errorf("invalid header size of %d bytes", len(header))}
+	}
+
+	version := binary.BigEndian.Uint16(header)
+	if version != Version {
+		return nil, fmt.Errorf("%w: %d", ErrVersion, version)
+	}
+
+	length := int(binary.BigEndian.Uint32(header[2:]))
+
+	numbers := make([]int, 0, len(body))
+	for number := range body {
+		numbers = append(numbers, number)
+	}
+
+	slices.Sort(numbers)
+
+	// Validate the lines before allocating the secret, the length in the
+	// header is not trusted.
+	total := 0
+	for i, number := range numbers {
+		if number != i+1 {
+			return nil, &LineError{Line: i + 1, Row: -1, Err: ErrMissingLine}
+		}
+
+		// All lines but the last one have the same length.
+		last := i == len(numbers)-1
+		if (!last && len(body[number]) != len(body[1])) || (last && len(body[number]) > len(body[1])) {
+			return nil, &LineError{Line: number, Row: -1, Err: fmt.Errorf("invalid line length")}
+		}
+
+		total += len(body[number])
+	}
+
+	if total < length {
+		return nil, &LineError{Line: len(numbers) + 1, Row: -1, Err: ErrMissingLine}
+	}
+
+	if total > length {
+		return nil, fmt.Errorf("invalid secret length, expect %d bytes, got %d", length, total)
+	}
+
+	secret := make([]byte, 0, total)
+	for _, number := range numbers {
+		secret = append(secret, body[number]...)
+	}
+
+	if !bytes.Equal(checksum(secret), header[6:]) {
+		clear(secret)
+		return nil, ErrDocumentChecksum
+	}
+
+	return secret, nil
+}
+
+// decodeLine decodes a single line of the form "<number>: <data> <check>".
+// If the line number can not be read, -1 is returned as line number.
+func decodeLine(text string) (int, []byte, error) {
+	prefix, quints, ok := strings.Cut(text, ":")
+	if !ok {
+		return -1, nil, fmt.Errorf("line number missing")
+	}
+
+	number, err := strconv.Atoi(strings.TrimSpace(prefix))
+	if err != nil || number < 0 || number > math.MaxUint16 {
+		return -1, nil, fmt.Errorf("invalid line number %q", strings.TrimSpace(prefix))
+	}
+
+	quints = strings.Join(strings.Fields(strings.ReplaceAll(quints, "-", " ")), "")
+	if len(quints) <= 5 {
+		return number, nil, fmt.Errorf("line too short")
+	}
+
+	check, err := proquint.ToUint16(strings.ToLower(quints[len(quints)-5:]))
+	if err != nil {
+		return number, nil, fmt.Errorf("invalid check syllable: %w", err)
+	}
+
+	data, err := proquint.ToBytes(quints[:len(quints)-5], proquint.WithDecodingPadding(proquint.HalfSyllablePadding))
+	if err != nil {
+		return number, nil, err
+	}
+
+	if lineCheck(number, data) != check {
+		clear(data)
+		return number, nil, ErrLineChecksum
+	}
+
+	return number, data, nil
+}
+
+// lineCheck returns the upper 16 bits of the CRC-32 of the line number and
+// the data of the line.
+func lineCheck(number int, data []byte) uint16 {
+	crc := crc32.NewIEEE()
+	_ = binary.Write(crc, binary.BigEndian, uint16(number))
+	_, _ = crc.Write(data)
+
+	return uint16(crc.Sum32() >> 16)
+}
+
+// checksum returns the first 4 bytes of the SHA-256 of the secret.
+func checksum(secret []byte) []byte {
+	sum := sha256.Sum256(secret)
+	return sum[:4]
+}
@@ -0,0 +1,260 @@
+package backup_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"hash/crc32"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+	"github.com/breml/proquint/backup"
+)
+
+// seed is an ed25519 seed of 32 bytes.
+var seed = []byte{
+	0x9d, 0x61, 0xb1, 0x9d, 0xef, 0xfd, 0x5a, 0x60, 0xba, 0x84, 0x4a, 0xf4, 0x92, 0xec, 0x2c, 0xc4,
+	0x44, 0x49, 0xc5, 0x69, 0x7b, 0x32, 0x69, 0x19, 0x70, 0x3b, 0xac, 0x03, 0x1c, 0xae, 0x7f, 0x60,
+}
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name   string
+		secret []byte
+		opts   []backup.Option
+	}{
+		{
+			name:   "ed25519 seed",
+			secret: seed,
+		},
+		{
+			name:   "odd length",
+			secret: seed[:31],
+		},
+		{
+			name:   "single byte",
+			secret: seed[:1],
+		},
+		{
+			name:   "syllables per line",
+			secret: seed,
+			opts:   []backup.Option{backup.WithSyllablesPerLine(1)},
+		},
+		{
+			name:   "full last line",
+			secret: seed[:24],
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			doc, err := backup.Encode(test.secret, test.opts...)
+			require.NoError(t, err)
+
+			got, err := backup.Decode(doc)
+			require.NoError(t, err)
+			require.Equal(t, test.secret, got)
+		})
+	}
+}
+
+func TestEncodeInvalid(t *testing.T) {
+	_, err := backup.Encode(nil)
+	require.Error(t, err)
+
+	_, err = backup.Encode(seed, backup.WithSyllablesPerLine(0))
+	require.Error(t, err)
+}
+
+func TestDecodeLinesInAnyOrder(t *testing.T) {
+	doc, err := backup.Encode(seed)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(doc), "\n")
+	slices.Reverse(lines)
+
+	// Retyped documents may differ in case and spacing and contain
+	// duplicate and empty lines.
+	lines = append(lines, "", strings.ToUpper(strings.ReplaceAll(lines[0], "-", " ")))
+
+	got, err := backup.Decode(strings.Join(lines, "\n"))
+	require.NoError(t, err)
+	require.Equal(t, seed, got)
+}
+
+func TestDecodeErrors(t *testing.T) {
+	doc, err := backup.Encode(seed)
+	require.NoError(t, err)
+
+	lines := strings.Split(strings.TrimSpace(doc), "\n")
+
+	replace := func(i int, line string) string {
+		modified := slices.Clone(lines)
+		modified[i] = line
+		return strings.Join(modified, "\n")
+	}
+
+	remove := func(i int) string {
+		return strings.Join(slices.Delete(slices.Clone(lines), i, i+1), "\n")
+	}
+
+	// swapSyllable replaces the first data syllable of line i.
+	swapSyllable := func(i int) string {
+		prefix, rest, _ := strings.Cut(lines[i], ": ")
+		return replace(i, prefix+": zuzuz"+rest[5:])
+	}
+
+	otherDoc, err := backup.Encode(bytes.Repeat([]byte{0x42}, 32))
+	require.NoError(t, err)
+	otherLines := strings.Split(otherDoc, "\n")
+
+	tests := []struct {
+		name string
+		doc  string
+
+		wantErr  error
+		wantLine int
+		wantRow  int
+	}{
+		{
+			name: "line checksum",
+			doc:  swapSyllable(2),
+
+			wantErr:  backup.ErrLineChecksum,
+			wantLine: 2,
+			wantRow:  3,
+		},
+		{
+			name: "header checksum",
+			doc:  swapSyllable(0),
+
+			wantErr:  backup.ErrLineChecksum,
+			wantLine: 0,
+			wantRow:  1,
+		},
+		{
+			name: "missing line",
+			doc:  remove(3),
+
+			wantErr:  backup.ErrMissingLine,
+			wantLine: 3,
+			wantRow:  -1,
+		},
+		{
+			name: "missing last line",
+			doc:  remove(len(lines) - 1),
+
+			wantErr:  backup.ErrMissingLine,
+			wantLine: len(lines) - 1,
+			wantRow:  -1,
+		},
+		{
+			name: "missing header",
+			doc:  remove(0),
+
+			wantErr:  backup.ErrMissingLine,
+			wantLine: 0,
+			wantRow:  -1,
+		},
+		{
+			name: "duplicate line",
+			doc:  strings.Join(append(slices.Clone(lines), otherLines[1]), "\n"),
+
+			wantErr:  backup.ErrDuplicateLine,
+			wantLine: 1,
+			wantRow:  len(lines) + 1,
+		},
+		{
+			name: "invalid line number",
+			doc:  replace(1, "x"+lines[1]),
+
+			wantLine: -1,
+			wantRow:  2,
+		},
+		{
+			name: "invalid final syllable",
+			doc:  replace(3, strings.Replace(lines[3], "lutob", "lut", 1)),
+
+			wantLine: 3,
+			wantRow:  4,
+		},
+		{
+			name: "invalid letter",
+			doc:  replace(1, strings.Replace(lines[1], ": ", ": c", 1)),
+
+			wantLine: 1,
+			wantRow:  2,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := backup.Decode(test.doc)
+
+			var lineErr *backup.LineError
+			require.ErrorAs(t, err, &lineErr)
+			require.Equal(t, test.wantLine, lineErr.Line)
+			require.Equal(t, test.wantRow, lineErr.Row)
+
+			if test.wantErr != nil {
+				require.ErrorIs(t, err, test.wantErr)
+			}
+		})
+	}
+}
+
+func TestDecodeDocumentChecksum(t *testing.T) {
+	doc, err := backup.Encode(seed)
+	require.NoError(t, err)
+
+	otherDoc, err := backup.Encode(bytes.Repeat([]byte{0x42}, 32))
+	require.NoError(t, err)
+
+	// Line 1 of another document has a valid line checksum.
+	lines := strings.Split(doc, "\n")
+	lines[1] = strings.Split(otherDoc, "\n")[1]
+
+	_, err = backup.Decode(strings.Join(lines, "\n"))
+	require.ErrorIs(t, err, backup.ErrDocumentChecksum)
+}
+
+// forgeLine returns a line with a valid check syllable.
+func forgeLine(t *testing.T, number int, data []byte) string {
+	t.Helper()
+
+	crc := crc32.NewIEEE()
+	_ = binary.Write(crc, binary.BigEndian, uint16(number))
+	_, _ = crc.Write(data)
+
+	quints, err := proquint.FromBytes(data, proquint.WithHyphens(), proquint.WithEncodingPadding(proquint.HalfSyllablePadding))
+	require.NoError(t, err)
+
+	return fmt.Sprintf("%d: %s %s", number, quints, proquint.FromUint16(uint16(crc.Sum32()>>16)))
+}
+
+func TestDecodeForgedLength(t *testing.T) {
+	// The header claims a secret of almost 4 GiB, followed by a single line.
+	header := []byte{0x00, 0x01, 0xFF, 0xFF, 0xFF, 0xF0, 0x00, 0x00, 0x00, 0x00}
+	doc := forgeLine(t, 0, header) + "\n" + forgeLine(t, 1, []byte{0x42, 0x42})
+
+	_, err := backup.Decode(doc)
+	require.ErrorIs(t, err, backup.ErrMissingLine)
+}
+
+func ExampleEncode() {
+	doc, err := backup.Encode(seed)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Print(doc)
+	// Output:
+	// 0: babad-babab-babob-kidat-jafor norik
+	// 1: nujod-rakit-vuzut-jonob-ropah-horuh lomof
+	// 2: naros-fugah-hidan-sijon-losuf-kohin hupob
+	// 3: labur-pubag-dufov-lutob tojud
+}
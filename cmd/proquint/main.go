@@ -0,0 +1,74 @@
+// Command proquint converts data to and from proquint dumps.
+//
+// Usage:
+//
+//	proquint dump   < data > dump.txt
+//	proquint undump < dump.txt > data
+//
+// The dump subcommand writes a dump of stdin to stdout in the format of
+// proquint.Dump. The undump subcommand reverses this.
+package main
+
+import (
+	"bufio"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/breml/proquint"
+)
+
+const usage = "usage: proquint dump|undump < input > output"
+
+func main() {
+	if err := run(os.Args[1:], os.Stdin, os.Stdout); err != nil {
+		fmt.Fprintln(os.Stderr, "proquint:", err)
+		os.Exit(1)
+	}
+}
+
+func run(args []string, stdin io.Reader, stdout io.Writer) error {
+	if len(args) != 1 {
+		return errors.New(usage)
+	}
+
+	switch args[0] {
+	case "dump":
+		return dump(stdin, stdout)
+	case "undump":
+		return undump(stdin, stdout)
+	default:
+		return fmt.Errorf("unknown subcommand %q, %s", args[0], usage)
+	}
+}
+
+func dump(r io.Reader, w io.Writer) error {
+	out := bufio.NewWriter(w)
+
+	dumper := proquint.Dumper(out)
+	if _, err := io.Copy(dumper, r); err != nil {
+		return err
+	}
+
+	if err := dumper.Close(); err != nil {
+		return err
+	}
+
+	return out.Flush()
+}
+
+func undump(r io.Reader, w io.Writer) error {
+	in, err := io.ReadAll(r)
+	if err != nil {
+		return err
+	}
+
+	data, err := proquint.Undump(string(in))
+	if err != nil {
+		return err
+	}
+
+	_, err = w.Write(data)
+	return err
+}
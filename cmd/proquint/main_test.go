@@ -0,0 +1,57 @@
+package main
+
+import (
+	"bytes"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestRun(t *testing.T) {
+	data := []byte("Hello, proquint!\nodd")
+
+	var dumped bytes.Buffer
+	err := run([]string{"dump"}, bytes.NewReader(data), &dumped)
+	require.NoError(t, err)
+	require.Equal(t, proquint.Dump(data), dumped.String())
+
+	var undumped bytes.Buffer
+	err = run([]string{"undump"}, &dumped, &undumped)
+	require.NoError(t, err)
+	require.Equal(t, data, undumped.Bytes())
+}
+
+func TestRunErrors(t *testing.T) {
+	tests := []struct {
+		name  string
+		args  []string
+		stdin string
+	}{
+		{
+			name: "missing subcommand",
+		},
+		{
+			name: "unknown subcommand",
+			args: []string{"hexdump"},
+		},
+		{
+			name: "too many arguments",
+			args: []string{"dump", "file"},
+		},
+		{
+			name:  "invalid dump",
+			args:  []string{"undump"},
+			stdin: "00000000  bXbab  |..|\n",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := run(tc.args, strings.NewReader(tc.stdin), &bytes.Buffer{})
+			require.Error(t, err)
+		})
+	}
+}
@@ -0,0 +1,32 @@
+package proquint
+
+import "slices"
+
+// Codec encodes and decodes byte slices with a single set of options. The
+// decoding options are derived from the encoding options with
+// DecodingOptionsFor, such that the padding, the byte order and the type tag
+// of both directions can not be mismatched. A Codec is safe for concurrent
+// use.
+type Codec struct {
+	encode []EncodingOption
+	decode []DecodingOption
+}
+
+// NewCodec returns a Codec, which encodes with the given options and decodes
+// with the matching decoding options.
+func NewCodec(opts ...EncodingOption) Codec {
+	return Codec{
+		encode: slices.Clone(opts),
+		decode: DecodingOptionsFor(opts...),
+	}
+}
+
+// Encode encodes the input like FromBytes.
+func (c Codec) Encode(in []byte) (string, error) {
+	return FromBytes(in, c.encode...)
+}
+
+// Decode decodes the proquint like ToBytes.
+func (c Codec) Decode(in string) ([]byte, error) {
+	return ToBytes(in, c.decode...)
+}
@@ -0,0 +1,94 @@
+package proquint_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestCodec(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []byte
+		opts []proquint.EncodingOption
+
+		want string
+	}{
+		{
+			name: "default",
+			in:   []byte{0x7F, 0x00, 0x00, 0x01},
+
+			want: "lusabbabad",
+		},
+		{
+			name: "zero byte padding",
+			in:   []byte{0x7F, 0x00, 0x01},
+			opts: []proquint.EncodingOption{proquint.WithEncodingPadding(proquint.ZeroBytePadding), proquint.WithHyphens()},
+
+			want: "lusab-bahab",
+		},
+		{
+			name: "half syllable padding",
+			in:   []byte{0x7F, 0x00, 0x01},
+			opts: []proquint.EncodingOption{proquint.WithEncodingPadding(proquint.HalfSyllablePadding), proquint.WithHyphens()},
+
+			want: "lusab-bah",
+		},
+		{
+			name: "word byte order and type tag",
+			in:   []byte{0x7F, 0x00, 0x00, 0x01},
+			opts: []proquint.EncodingOption{
+				proquint.WithEncodingWordByteOrder(binary.LittleEndian),
+				proquint.WithTypeTag(7),
+				proquint.WithHyphens(),
+			},
+
+			want: "babal-baduz-bahab",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			codec := proquint.NewCodec(tc.opts...)
+
+			quint, err := codec.Encode(tc.in)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, quint)
+
+			got, err := codec.Decode(quint)
+			require.NoError(t, err)
+			require.Equal(t, tc.in, got)
+		})
+	}
+}
+
+func TestCodecOptionsNotShared(t *testing.T) {
+	opts := make([]proquint.EncodingOption, 1, 2)
+	opts[0] = proquint.WithEncodingPadding(proquint.HalfSyllablePadding)
+
+	codec := proquint.NewCodec(opts...)
+
+	// Modifying the options of the caller does not change the Codec.
+	opts[0] = proquint.WithEncodingPadding(proquint.NoPadding)
+
+	quint, err := codec.Encode([]byte{0x7F})
+	require.NoError(t, err)
+	require.Equal(t, "lus", quint)
+}
+
+func ExampleCodec() {
+	codec := proquint.NewCodec(proquint.WithEncodingPadding(proquint.HalfSyllablePadding), proquint.WithHyphens())
+
+	quint, _ := codec.Encode([]byte{0x7F, 0x00, 0x01})
+	fmt.Println(quint)
+
+	data, _ := codec.Decode(quint)
+	fmt.Println(data)
+	// Output:
+	// lusab-bah
+	// [127 0 1]
+}
@@ -1,37 +1,80 @@
 package proquint
 
 import (
+	"encoding/binary"
 	"fmt"
+	"math/big"
 	"strings"
+	"unsafe"
 )
 
 type decodingConfig struct {
-	finalZeroBytePadding bool
-	finalHyphenPadding   bool
+	padding       Padding
+	byteOrder     binary.ByteOrder
+	wordByteOrder binary.ByteOrder
+	hasTypeTag    bool
+	typeTag       uint16
+	parseTypeTag  bool
 }
 
 type DecodingOption func(*decodingConfig)
 
+// WithDecodingByteOrder sets the byte order, in which integers have been
+// encoded. The default is binary.BigEndian.
+func WithDecodingByteOrder(order binary.ByteOrder) DecodingOption {
+	return func(cfg *decodingConfig) {
+		cfg.byteOrder = order
+	}
+}
+
+// WithDecodingWordByteOrder sets the byte order of the 16 bit words, which
+// are decoded by ToBytes from each syllable. The default is
+// binary.BigEndian.
+func WithDecodingWordByteOrder(order binary.ByteOrder) DecodingOption {
+	return func(cfg *decodingConfig) {
+		cfg.wordByteOrder = order
+	}
+}
+
+// WithDecodingTypeTag expects a leading type-tag syllable containing the
+// given tag. The type-tag syllable is removed from the decoded value.
+func WithDecodingTypeTag(tag uint16) DecodingOption {
+	return func(cfg *decodingConfig) {
+		cfg.hasTypeTag = true
+		cfg.typeTag = tag
+	}
+}
+
+// WithDecodingPadding sets the Padding, which is removed from the decoded
+// value. It should be the same Padding, which has been passed to
+// WithEncodingPadding for encoding.
+func WithDecodingPadding(padding Padding) DecodingOption {
+	return func(cfg *decodingConfig) {
+		cfg.padding = padding
+	}
+}
+
 // WithFinalZeroBytePadding treats a final 0x00 byte as padding
 // and therefore removes it from the returned value.
+// It is equivalent to WithDecodingPadding(ZeroBytePadding).
 func WithFinalZeroBytePadding() DecodingOption {
-	return func(cfg *decodingConfig) {
-		cfg.finalZeroBytePadding = true
-	}
+	return WithDecodingPadding(ZeroBytePadding)
 }
 
 // WithFinalHyphenPadding treats a final hyphen as indicator, that
 // a final 0x00 byte is a padding byte and therefore removes it from
 // the returned value.
+// It is equivalent to WithDecodingPadding(FinalHyphenPadding).
 func WithFinalHyphenPadding() DecodingOption {
-	return func(cfg *decodingConfig) {
-		cfg.finalHyphenPadding = true
-	}
+	return WithDecodingPadding(FinalHyphenPadding)
 }
 
 // ToBytes decodes a proquint string to a slice of bytes.
 func ToBytes(in string, opts ...DecodingOption) ([]byte, error) {
-	cfg := decodingConfig{}
+	cfg := decodingConfig{
+		padding:       NoPadding,
+		wordByteOrder: binary.BigEndian,
+	}
 
 	for _, opt := range opts {
 		opt(&cfg)
@@ -40,35 +83,156 @@ func ToBytes(in string, opts ...DecodingOption) ([]byte, error) {
 	hasFinalHyphen := strings.HasSuffix(in, "-")
 	in = strings.ToLower(strings.ReplaceAll(in, "-", ""))
 
-	if len(in)%5 != 0 {
-		return nil, fmt.Errorf("invalid proquint, length not multiple of 5")
+	if cfg.hasTypeTag {
+		if len(in) < 5 {
+			return nil, fmt.Errorf("invalid input, type tag missing")
+		}
+
+		err := checkTypeTag(in[:5], cfg.typeTag)
+		if err != nil {
+			return nil, err
+		}
+
+		in = in[5:]
+	}
+
+	// Letters not forming a complete syllable are handled by the padding.
+	suffix := in[len(in)-len(in)%5:]
+	in = in[:len(in)-len(suffix)]
+	if hasFinalHyphen {
+		suffix += "-"
 	}
 
-	res := make([]byte, 0, len(in)/5)
+	// Reserve room for the final byte of a half syllable, see
+	// HalfSyllablePadding, such that appending it does not leave a copy of
+	// the decoded bytes behind.
+	res := make([]byte, 0, len(in)/5*2+1)
 
 	for i := 0; i < len(in)/5; i++ {
-		ui16, err := ToUint16(in[i*5 : (i+1)*5])
+		ui16, err := decodeSyllable(in[i*5 : (i+1)*5])
 		if err != nil {
+			clear(res)
 			return nil, err
 		}
 
-		res = append(res, byte(ui16>>8))
-		res = append(res, byte(ui16))
+		res = append(res, 0, 0)
+		cfg.wordByteOrder.PutUint16(res[len(res)-2:], ui16)
 	}
 
-	finalByte := res[len(res)-1]
-	isFinalHyphenPadding := cfg.finalHyphenPadding && hasFinalHyphen && finalByte == 0
-	isZeroBytePadding := cfg.finalZeroBytePadding && finalByte == 0
-	if isFinalHyphenPadding || isZeroBytePadding {
-		// Strip final byte, since it is 0x00 and padding is enabled.
-		res = res[:len(res)-1]
+	out, err := cfg.padding.Unpad(res, suffix)
+	if err != nil {
+		// Wipe the decoded bytes, even if a custom Padding does not.
+		clear(res)
+		return nil, err
 	}
 
-	return res, nil
+	return out, nil
 }
 
-// ToUint16 decodes a proquint syllable to uint16.
-func ToUint16(in string) (uint16, error) {
+// Decode decodes a proquint string to the integer type T. The number of
+// expected proquint syllables is derived from the size of T, where 8 bit
+// types are decoded from a single syllable. The syllables may be separated
+// by hyphens.
+func Decode[T Integer](in string, opts ...DecodingOption) (T, error) {
+	cfg := decodingConfig{
+		byteOrder: binary.BigEndian,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var res T
+
+	size := int(unsafe.Sizeof(res))
+	quints, err := stripTypeTag(splitQuints(in), cfg)
+	if err != nil {
+		return 0, err
+	}
+
+	if len(quints) != syllables(size) {
+		return 0, fmt.Errorf("invalid input, expect %d quints, got %d", syllables(size), len(quints))
+	}
+
+	var ui64 uint64
+
+	for _, quint := range quints {
+		ui16, err := decodeSyllable(quint)
+		if err != nil {
+			return 0, err
+		}
+
+		ui64 = ui64<<16 + uint64(ui16)
+	}
+
+	if size < 8 && ui64 >= 1<<(8*size) {
+		return 0, fmt.Errorf("invalid input, value %#x overflows %d bit integer", ui64, 8*size)
+	}
+
+	return T(fromByteOrder(ui64, size, cfg.byteOrder)), nil
+}
+
+// fromByteOrder returns the integer of size bytes, whose representation
+// in the given byte order equals the big-endian representation of in.
+func fromByteOrder(in uint64, size int, order binary.ByteOrder) uint64 {
+	buf := make([]byte, 8)
+
+	switch size {
+	case 2:
+		binary.BigEndian.PutUint16(buf, uint16(in))
+		return uint64(order.Uint16(buf))
+	case 4:
+		binary.BigEndian.PutUint32(buf, uint32(in))
+		return uint64(order.Uint32(buf))
+	case 8:
+		binary.BigEndian.PutUint64(buf, in)
+		return order.Uint64(buf)
+	default:
+		// A single byte does not have a byte order.
+		return in
+	}
+}
+
+// stripTypeTag removes the leading type-tag syllable, if a type tag is
+// configured.
+func stripTypeTag(quints []string, cfg decodingConfig) ([]string, error) {
+	if !cfg.hasTypeTag {
+		return quints, nil
+	}
+
+	if len(quints) == 0 {
+		return nil, fmt.Errorf("invalid input, type tag missing")
+	}
+
+	err := checkTypeTag(quints[0], cfg.typeTag)
+	if err != nil {
+		return nil, err
+	}
+
+	return quints[1:], nil
+}
+
+// splitQuints splits a proquint string into its syllables. If the string
+// does not contain hyphens, it is split into chunks of 5 characters.
+func splitQuints(in string) []string {
+	if in == "" {
+		return nil
+	}
+
+	if strings.Contains(in, "-") {
+		return strings.Split(in, "-")
+	}
+
+	quints := make([]string, 0, (len(in)+4)/5)
+	for len(in) > 5 {
+		quints = append(quints, in[:5])
+		in = in[5:]
+	}
+
+	return append(quints, in)
+}
+
+func decodeSyllable(in string) (uint16, error) {
 	if len(in) != 5 {
 		return 0, fmt.Errorf("invalid quint %q does not have 5 characters", in)
 	}
@@ -106,70 +270,111 @@ func indexOf(letter byte, table []byte) (uint16, error) {
 	return 0, fmt.Errorf("invalid letter %q in quint", string([]byte{letter}))
 }
 
+// ToUint16 decodes a proquint syllable to uint16.
+func ToUint16(in string, opts ...DecodingOption) (uint16, error) {
+	return Decode[uint16](in, opts...)
+}
+
 // ToInt16 decodes a proquint syllable to int16.
-func ToInt16(in string) (int16, error) {
-	ui16, err := ToUint16(in)
-	return int16(ui16), err
+func ToInt16(in string, opts ...DecodingOption) (int16, error) {
+	return Decode[int16](in, opts...)
 }
 
 // ToUint32 decodes two proquint syllables to uint32.
-func ToUint32(in string) (uint32, error) {
-	quints := strings.Split(in, "-")
-	if len(quints) != 2 {
-		return 0, fmt.Errorf("invalid input, expect 2 quints, got %d", len(quints))
+func ToUint32(in string, opts ...DecodingOption) (uint32, error) {
+	return Decode[uint32](in, opts...)
+}
+
+// ToInt32 decodes two proquint syllables to int32.
+func ToInt32(in string, opts ...DecodingOption) (int32, error) {
+	return Decode[int32](in, opts...)
+}
+
+// ToUint64 decodes four proquint syllables to uint64.
+func ToUint64(in string, opts ...DecodingOption) (uint64, error) {
+	return Decode[uint64](in, opts...)
+}
+
+// ToInt64 decodes four proquint syllables to int64.
+func ToInt64(in string, opts ...DecodingOption) (int64, error) {
+	return Decode[int64](in, opts...)
+}
+
+// ToUint128 decodes eight proquint syllables to a 128 bit unsigned integer,
+// returned as its upper (hi) and lower (lo) 64 bits.
+func ToUint128(in string, opts ...DecodingOption) (hi, lo uint64, err error) {
+	cfg := decodingConfig{
+		byteOrder: binary.BigEndian,
 	}
 
-	var res uint32
+	for _, opt := range opts {
+		opt(&cfg)
+	}
 
-	for i, quint := range quints {
-		ui16, err := ToUint16(quint)
+	quints, err := stripTypeTag(splitQuints(in), cfg)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	if len(quints) != 8 {
+		return 0, 0, fmt.Errorf("invalid input, expect 8 quints, got %d", len(quints))
+	}
+
+	buf := make([]byte, 0, 16)
+
+	for _, quint := range quints {
+		ui16, err := decodeSyllable(quint)
 		if err != nil {
-			return 0, err
+			return 0, 0, err
 		}
 
-		if i != 0 {
-			res <<= 16
-		}
+		buf = binary.BigEndian.AppendUint16(buf, ui16)
+	}
+
+	hi = cfg.byteOrder.Uint64(buf)
+	lo = cfg.byteOrder.Uint64(buf[8:])
 
-		res += uint32(ui16)
+	if isLittleEndian(cfg.byteOrder) {
+		// The lower 64 bits are stored first.
+		hi, lo = lo, hi
 	}
 
-	return res, nil
+	return hi, lo, nil
 }
 
-// ToInt32 decodes two proquint syllables to int32.
-func ToInt32(in string) (int32, error) {
-	ui32, err := ToUint32(in)
-	return int32(ui32), err
-}
+// ToBigInt decodes a proquint string to a big.Int. A leading hyphen
+// indicates a negative value. The options need to match the options
+// passed to FromBigInt. Since FromBigInt always encodes an even number of
+// bytes, ZeroBytePadding and FinalHyphenPadding are ignored, a final 0x00
+// byte is never removed.
+func ToBigInt(in string, opts ...DecodingOption) (*big.Int, error) {
+	negative := strings.HasPrefix(in, "-")
+	if negative {
+		in = in[1:]
+	}
 
-// ToUint64 decodes four proquint syllables to uint64.
-func ToUint64(in string) (uint64, error) {
-	quints := strings.Split(in, "-")
-	if len(quints) != 4 {
-		return 0, fmt.Errorf("invalid input, expect 4 quints, got %d", len(quints))
+	cfg := decodingConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
 	}
 
-	var res uint64
+	if cfg.padding == ZeroBytePadding || cfg.padding == FinalHyphenPadding {
+		opts = append(opts[:len(opts):len(opts)], WithDecodingPadding(NoPadding))
+	}
 
-	for i, quint := range quints {
-		ui16, err := ToUint16(quint)
-		if err != nil {
-			return 0, err
-		}
+	abs, err := ToBytes(in, opts...)
+	if err != nil {
+		return nil, err
+	}
 
-		if i != 0 {
-			res <<= 16
-		}
+	if len(abs) == 0 {
+		return nil, fmt.Errorf("invalid input, no quints")
+	}
 
-		res += uint64(ui16)
+	res := new(big.Int).SetBytes(abs)
+	if negative {
+		res.Neg(res)
 	}
 
 	return res, nil
 }
-
-// ToInt64 decodes four proquint syllables to int64.
-func ToInt64(in string) (int64, error) {
-	ui64, err := ToUint64(in)
-	return int64(ui64), err
-}
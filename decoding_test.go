@@ -1,6 +1,8 @@
 package proquint_test
 
 import (
+	"encoding/binary"
+	"math/big"
 	"testing"
 
 	"github.com/stretchr/testify/require"
@@ -315,3 +317,318 @@ func TestToInt64(t *testing.T) {
 		})
 	}
 }
+
+func TestDecode(t *testing.T) {
+	type userID uint32
+
+	u8, err := proquint.Decode[uint8]("bagaz")
+	require.NoError(t, err)
+	require.Equal(t, uint8(0xCF), u8)
+
+	i8, err := proquint.Decode[int8]("bagaz")
+	require.NoError(t, err)
+	require.Equal(t, int8(-0x31), i8)
+
+	_, err = proquint.Decode[uint8]("zuzuz")
+	require.Error(t, err, "value overflows 8 bit")
+
+	i16, err := proquint.Decode[int16]("zuzuz")
+	require.NoError(t, err)
+	require.Equal(t, int16(-1), i16)
+
+	id, err := proquint.Decode[userID]("lusab-babad")
+	require.NoError(t, err)
+	require.Equal(t, userID(0x7F000001), id)
+
+	id, err = proquint.Decode[userID]("lusabbabad")
+	require.NoError(t, err)
+	require.Equal(t, userID(0x7F000001), id)
+
+	u64, err := proquint.Decode[uint64]("babab-babab-babab-bagav")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0xCE), u64)
+
+	_, err = proquint.Decode[uint64]("babab-babab-bagav")
+	require.Error(t, err, "too few quints")
+
+	_, err = proquint.Decode[uint32]("lusab-bab-ad")
+	require.Error(t, err, "too many quints")
+
+	_, err = proquint.Decode[uint32]("lus-abbabad")
+	require.Error(t, err, "invalid quint length")
+
+	_, err = proquint.Decode[uint16]("")
+	require.Error(t, err, "empty input")
+}
+
+func TestToUint128(t *testing.T) {
+	hi, lo, err := proquint.ToUint128("kivaf-damur-zabal-hilup-pokum-figib-datoz-pugih")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x6782123bf00745fa), hi)
+	require.Equal(t, uint64(0xa9b824d0136facd4), lo)
+
+	hi, lo, err = proquint.ToUint128("bababbababbababbababbababbababbababbagav")
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), hi)
+	require.Equal(t, uint64(0xCE), lo)
+
+	_, _, err = proquint.ToUint128("kivaf-damur-zabal-hilup")
+	require.Error(t, err)
+
+	_, _, err = proquint.ToUint128("kivaf-damur-zabal-hilup-pokum-figib-datoz-puXih")
+	require.Error(t, err)
+}
+
+func TestToBigInt(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		opts []proquint.DecodingOption
+
+		assertErr require.ErrorAssertionFunc
+		want      *big.Int
+	}{
+		{
+			name: "zero",
+			in:   "babab",
+
+			assertErr: require.NoError,
+			want:      big.NewInt(0),
+		},
+		{
+			name: "negative",
+			in:   "-babab-bahaf",
+
+			assertErr: require.NoError,
+			want:      big.NewInt(-0x0102),
+		},
+		{
+			name: "more than 64 bit",
+			in:   "babad-babab-babab-babab-babab",
+
+			assertErr: require.NoError,
+			want:      new(big.Int).Lsh(big.NewInt(1), 64),
+		},
+		{
+			name: "length prefix padding",
+			in:   "babah-lusab-babad",
+			opts: []proquint.DecodingOption{
+				proquint.WithDecodingPadding(proquint.LengthPrefixPadding),
+			},
+
+			assertErr: require.NoError,
+			want:      big.NewInt(0x7F000001),
+		},
+		{
+			name: "error - empty",
+			in:   "-",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "error - invalid character",
+			in:   "baXab",
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := proquint.ToBigInt(tc.in, tc.opts...)
+			tc.assertErr(t, err)
+
+			if tc.want == nil {
+				require.Nil(t, got)
+				return
+			}
+
+			require.Zero(t, tc.want.Cmp(got), "want %s, got %s", tc.want, got)
+		})
+	}
+}
+
+func TestDecodingByteOrder(t *testing.T) {
+	le := proquint.WithDecodingByteOrder(binary.LittleEndian)
+	be := proquint.WithDecodingByteOrder(binary.BigEndian)
+
+	u8, err := proquint.Decode[uint8]("bagaz", le)
+	require.NoError(t, err)
+	require.Equal(t, uint8(0xCF), u8, "single byte has no byte order")
+
+	u16, err := proquint.ToUint16("damuh", be)
+	require.NoError(t, err)
+	require.Equal(t, uint16(0x1234), u16)
+
+	u16, err = proquint.ToUint16("gibif", le)
+	require.NoError(t, err)
+	require.Equal(t, uint16(0x1234), u16)
+
+	u32, err := proquint.ToUint32("bulub-gibif", le)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0x1234F00D), u32)
+
+	i32, err := proquint.ToInt32("bahab-baduz", le)
+	require.NoError(t, err)
+	require.Equal(t, int32(0x7F000001), i32)
+
+	u64, err := proquint.ToUint64("bahaf-basah-bihak-bisam", be)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x0102030405060708), u64)
+
+	u64, err = proquint.ToUint64("bobal-bimaj-bibag-bamad", le)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x0102030405060708), u64)
+
+	hi, lo, err := proquint.ToUint128("dabaz-bumat-bubar-boman-bobal-bimaj-bibag-bamad", le)
+	require.NoError(t, err)
+	require.Equal(t, uint64(0x0102030405060708), hi)
+	require.Equal(t, uint64(0x090a0b0c0d0e0f10), lo)
+}
+
+func TestDecodingWordByteOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		opts []proquint.DecodingOption
+
+		want []byte
+	}{
+		{
+			name: "big-endian",
+			in:   "bahaf-basah",
+			opts: []proquint.DecodingOption{
+				proquint.WithDecodingWordByteOrder(binary.BigEndian),
+			},
+
+			want: []byte{1, 2, 3, 4},
+		},
+		{
+			name: "little-endian",
+			in:   "bamad-bibag",
+			opts: []proquint.DecodingOption{
+				proquint.WithDecodingWordByteOrder(binary.LittleEndian),
+			},
+
+			want: []byte{1, 2, 3, 4},
+		},
+		{
+			name: "little-endian with zero byte padding",
+			in:   "bamad-babag",
+			opts: []proquint.DecodingOption{
+				proquint.WithDecodingWordByteOrder(binary.LittleEndian),
+				proquint.WithFinalZeroBytePadding(),
+			},
+
+			want: []byte{1, 2, 3},
+		},
+		{
+			name: "little-endian with half syllable padding",
+			in:   "bamad-bas",
+			opts: []proquint.DecodingOption{
+				proquint.WithDecodingWordByteOrder(binary.LittleEndian),
+				proquint.WithDecodingPadding(proquint.HalfSyllablePadding),
+			},
+
+			want: []byte{1, 2, 3},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			got, err := proquint.ToBytes(tc.in, tc.opts...)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestBigIntRoundTripPadding(t *testing.T) {
+	paddings := []struct {
+		name    string
+		padding proquint.Padding
+	}{
+		{name: "no padding", padding: proquint.NoPadding},
+		{name: "zero byte", padding: proquint.ZeroBytePadding},
+		{name: "final hyphen", padding: proquint.FinalHyphenPadding},
+		{name: "length prefix", padding: proquint.LengthPrefixPadding},
+		{name: "half syllable", padding: proquint.HalfSyllablePadding},
+	}
+
+	// All values end with a 0x00 byte.
+	values := []*big.Int{
+		big.NewInt(0),
+		big.NewInt(0x0100),
+		big.NewInt(-0x0100),
+		big.NewInt(0x7F000100),
+		new(big.Int).Lsh(big.NewInt(1), 64),
+	}
+
+	for _, tc := range paddings {
+		t.Run(tc.name, func(t *testing.T) {
+			for _, value := range values {
+				quint, err := proquint.FromBigInt(value, 1, proquint.WithEncodingPadding(tc.padding))
+				require.NoError(t, err)
+
+				got, err := proquint.ToBigInt(quint, proquint.WithDecodingPadding(tc.padding))
+				require.NoError(t, err)
+				require.Zero(t, value.Cmp(got), "want %s, got %s from %s", value, got, quint)
+			}
+		})
+	}
+}
+
+func TestDecodingOptionsFor(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []byte
+		opts []proquint.EncodingOption
+	}{
+		{
+			name: "default",
+			in:   []byte{0x7F, 0x00, 0x00, 0x01},
+		},
+		{
+			name: "word byte order",
+			in:   []byte{0x7F, 0x00, 0x00, 0x01},
+			opts: []proquint.EncodingOption{proquint.WithEncodingWordByteOrder(binary.LittleEndian)},
+		},
+		{
+			name: "type tag",
+			in:   []byte{0x7F, 0x00, 0x00, 0x01},
+			opts: []proquint.EncodingOption{proquint.WithTypeTag(7), proquint.WithHyphens()},
+		},
+		{
+			name: "padding",
+			in:   []byte{0x7F, 0x00, 0x00},
+			opts: []proquint.EncodingOption{proquint.WithPaddingFinalHyphen()},
+		},
+		{
+			name: "all",
+			in:   []byte{0x7F, 0x00, 0x00},
+			opts: []proquint.EncodingOption{
+				proquint.WithEncodingWordByteOrder(binary.LittleEndian),
+				proquint.WithTypeTag(7),
+				proquint.WithEncodingPadding(proquint.LengthPrefixPadding),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			quint, err := proquint.FromBytes(tc.in, tc.opts...)
+			require.NoError(t, err)
+
+			got, err := proquint.ToBytes(quint, proquint.DecodingOptionsFor(tc.opts...)...)
+			require.NoError(t, err)
+			require.Equal(t, tc.in, got)
+		})
+	}
+
+	// The byte order of integers is passed on, too.
+	quint := proquint.FromUint32(0x7F000001, proquint.WithEncodingByteOrder(binary.LittleEndian))
+	got, err := proquint.ToUint32(quint, proquint.DecodingOptionsFor(proquint.WithEncodingByteOrder(binary.LittleEndian))...)
+	require.NoError(t, err)
+	require.Equal(t, uint32(0x7F000001), got)
+}
@@ -0,0 +1,233 @@
+package proquint
+
+import (
+	"math"
+	"strings"
+)
+
+// ConfusionModel defines, how easily two letters are confused with each
+// other.
+type ConfusionModel interface {
+	// Substitution returns the cost of substituting the letter a with the
+	// letter b, between 0 (a and b can not be told apart) and 1 (a and b are
+	// never confused). The cost of substituting a letter with itself is 0.
+	Substitution(a, b byte) float64
+}
+
+// ConfusionModelFunc is a function implementing ConfusionModel.
+type ConfusionModelFunc func(a, b byte) float64
+
+// Substitution returns f(a, b).
+func (f ConfusionModelFunc) Substitution(a, b byte) float64 {
+	return f(a, b)
+}
+
+var (
+	// HammingModel counts every substitution of a letter with another letter
+	// with a cost of 1.
+	HammingModel ConfusionModel = ConfusionModelFunc(hammingSubstitution)
+
+	// KeyboardModel models typing errors on a QWERTY keyboard. Substituting a
+	// letter with the letter of an adjacent key costs 0.5, any other
+	// substitution costs 1.
+	KeyboardModel ConfusionModel = ConfusionModelFunc(keyboardSubstitution)
+
+	// AcousticModel models listening errors. The cost of substituting a
+	// consonant with another consonant is based on the differences in voicing,
+	// place and manner of articulation, e.g. b and p only differ in voicing.
+	// The cost of substituting a vowel with another vowel is based on the
+	// differences in height and backness. Substituting a consonant with a
+	// vowel or any other letter costs 1.
+	AcousticModel ConfusionModel = ConfusionModelFunc(acousticSubstitution)
+)
+
+// Distance returns the sum of the substitution costs of the letters of a and
+// b according to the model. Case and hyphens are ignored. Letters missing in
+// the shorter proquint are counted with a cost of 1. The higher the distance,
+// the less likely a and b are confused.
+func Distance(a, b string, model ConfusionModel) float64 {
+	a = normalizeLetters(a)
+	b = normalizeLetters(b)
+
+	distance := float64(max(len(a), len(b)) - min(len(a), len(b)))
+	for i := range min(len(a), len(b)) {
+		distance += model.Substitution(a[i], b[i])
+	}
+
+	return distance
+}
+
+// Confusability returns the mean similarity (1 minus the substitution cost)
+// of all pairs of distinct letters of the alphabet according to the model.
+// It allows to compare alphabets, e.g. a custom set of consonants, with each
+// other. The lower the confusability, the better the letters of the alphabet
+// can be told apart.
+func Confusability(alphabet string, model ConfusionModel) float64 {
+	alphabet = strings.ToLower(alphabet)
+
+	var sum float64
+	pairs := 0
+
+	for i := 0; i < len(alphabet); i++ {
+		for j := i + 1; j < len(alphabet); j++ {
+			if alphabet[i] == alphabet[j] {
+				continue
+			}
+
+			sum += 1 - model.Substitution(alphabet[i], alphabet[j])
+			pairs++
+		}
+	}
+
+	if pairs == 0 {
+		return 0
+	}
+
+	return sum / float64(pairs)
+}
+
+func hammingSubstitution(a, b byte) float64 {
+	if a == b {
+		return 0
+	}
+
+	return 1
+}
+
+// keyboardRows are the letter rows of a QWERTY keyboard with the horizontal
+// offset of each row in keys.
+var keyboardRows = []struct {
+	keys   string
+	offset float64
+}{
+	{keys: "qwertyuiop", offset: 0},
+	{keys: "asdfghjkl", offset: 0.25},
+	{keys: "zxcvbnm", offset: 0.75},
+}
+
+func keyboardSubstitution(a, b byte) float64 {
+	if a == b {
+		return 0
+	}
+
+	ax, ay, okA := keyPosition(a)
+	bx, by, okB := keyPosition(b)
+	if !okA || !okB {
+		return 1
+	}
+
+	if math.Hypot(ax-bx, ay-by) <= 1.25 {
+		return 0.5
+	}
+
+	return 1
+}
+
+func keyPosition(letter byte) (float64, float64, bool) {
+	for y, row := range keyboardRows {
+		x := strings.IndexByte(row.keys, letter)
+		if x >= 0 {
+			return float64(x) + row.offset, float64(y), true
+		}
+	}
+
+	return 0, 0, false
+}
+
+// Places of articulation, ordered from the lips to the throat.
+const (
+	bilabial = iota
+	labiodental
+	alveolar
+	postalveolar
+	velar
+	glottal
+)
+
+// Manners of articulation.
+const (
+	plosive = iota
+	fricative
+	affricate
+	nasal
+	lateral
+	approximant
+)
+
+type consonantFeatures struct {
+	voiced bool
+	place  int
+	manner int
+}
+
+// consonantFeatureTable contains the features of the proquint consonants
+// as pronounced in English.
+var consonantFeatureTable = map[byte]consonantFeatures{
+	'b': {voiced: true, place: bilabial, manner: plosive},
+	'd': {voiced: true, place: alveolar, manner: plosive},
+	'f': {voiced: false, place: labiodental, manner: fricative},
+	'g': {voiced: true, place: velar, manner: plosive},
+	'h': {voiced: false, place: glottal, manner: fricative},
+	'j': {voiced: true, place: postalveolar, manner: affricate},
+	'k': {voiced: false, place: velar, manner: plosive},
+	'l': {voiced: true, place: alveolar, manner: lateral},
+	'm': {voiced: true, place: bilabial, manner: nasal},
+	'n': {voiced: true, place: alveolar, manner: nasal},
+	'p': {voiced: false, place: bilabial, manner: plosive},
+	'r': {voiced: true, place: alveolar, manner: approximant},
+	's': {voiced: false, place: alveolar, manner: fricative},
+	't': {voiced: false, place: alveolar, manner: plosive},
+	'v': {voiced: true, place: labiodental, manner: fricative},
+	'z': {voiced: true, place: alveolar, manner: fricative},
+}
+
+type vowelFeatures struct {
+	// height is 0 for high, 1 for mid and 2 for low vowels.
+	height int
+
+	// backness is 0 for front, 1 for central and 2 for back vowels.
+	backness int
+}
+
+// vowelFeatureTable contains the features of the proquint vowels.
+var vowelFeatureTable = map[byte]vowelFeatures{
+	'a': {height: 2, backness: 1},
+	'i': {height: 0, backness: 0},
+	'o': {height: 1, backness: 2},
+	'u': {height: 0, backness: 2},
+}
+
+func acousticSubstitution(a, b byte) float64 {
+	if a == b {
+		return 0
+	}
+
+	ca, okA := consonantFeatureTable[a]
+	cb, okB := consonantFeatureTable[b]
+	if okA && okB {
+		var cost float64
+		if ca.voiced != cb.voiced {
+			cost++
+		}
+
+		// Neighboring places of articulation are more easily confused.
+		cost += math.Min(math.Abs(float64(ca.place-cb.place)), 2) / 2
+
+		if ca.manner != cb.manner {
+			cost++
+		}
+
+		return cost / 3
+	}
+
+	va, okA := vowelFeatureTable[a]
+	vb, okB := vowelFeatureTable[b]
+	if okA && okB {
+		height := math.Abs(float64(va.height-vb.height)) / 2
+		backness := math.Abs(float64(va.backness-vb.backness)) / 2
+
+		return (height + backness) / 2
+	}
+
+	return 1
+}
@@ -0,0 +1,155 @@
+package proquint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestDistance(t *testing.T) {
+	tests := []struct {
+		name  string
+		a     string
+		b     string
+		model proquint.ConfusionModel
+
+		want float64
+	}{
+		{
+			name:  "hamming equal",
+			a:     "lusab-babad",
+			b:     "LUSABBABAD",
+			model: proquint.HammingModel,
+
+			want: 0,
+		},
+		{
+			name:  "hamming",
+			a:     "lusab-babad",
+			b:     "lusab-babat",
+			model: proquint.HammingModel,
+
+			want: 1,
+		},
+		{
+			name:  "hamming different length",
+			a:     "lusab-babad",
+			b:     "lusab",
+			model: proquint.HammingModel,
+
+			want: 5,
+		},
+		{
+			name:  "keyboard adjacent",
+			a:     "lusab-babad",
+			b:     "lusab-bavad",
+			model: proquint.KeyboardModel,
+
+			want: 0.5,
+		},
+		{
+			name:  "keyboard distant",
+			a:     "lusab-babad",
+			b:     "lusab-zabad",
+			model: proquint.KeyboardModel,
+
+			want: 1,
+		},
+		{
+			name:  "acoustic voicing",
+			a:     "lusab-babad",
+			b:     "lusab-babat",
+			model: proquint.AcousticModel,
+
+			want: 1.0 / 3,
+		},
+		{
+			name:  "acoustic place and manner",
+			a:     "lusab",
+			b:     "lusav",
+			model: proquint.AcousticModel,
+
+			want: (0.5 + 1) / 3,
+		},
+		{
+			name:  "acoustic vowel",
+			a:     "lusab",
+			b:     "losab",
+			model: proquint.AcousticModel,
+
+			want: 0.25,
+		},
+		{
+			name:  "acoustic consonant and vowel",
+			a:     "lusab",
+			b:     "uusab",
+			model: proquint.AcousticModel,
+
+			want: 1,
+		},
+		{
+			name: "custom model",
+			a:    "lusab",
+			b:    "lusad",
+			model: proquint.ConfusionModelFunc(func(a, b byte) float64 {
+				return 0.1
+			}),
+
+			want: 0.5,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.InDelta(t, test.want, proquint.Distance(test.a, test.b, test.model), 1e-9)
+		})
+	}
+}
+
+func TestSubstitution(t *testing.T) {
+	letters := "bdfghjklmnprstvzaiou"
+
+	for _, model := range []proquint.ConfusionModel{proquint.HammingModel, proquint.KeyboardModel, proquint.AcousticModel} {
+		for i := range len(letters) {
+			for j := range len(letters) {
+				cost := model.Substitution(letters[i], letters[j])
+				require.Equal(t, cost, model.Substitution(letters[j], letters[i]), "symmetric %c %c", letters[i], letters[j])
+
+				if i == j {
+					require.Zero(t, cost)
+					continue
+				}
+
+				// The proquint letters are always distinguishable.
+				require.Greater(t, cost, 0.0, "%c %c", letters[i], letters[j])
+				require.LessOrEqual(t, cost, 1.0, "%c %c", letters[i], letters[j])
+			}
+		}
+	}
+}
+
+func TestConfusability(t *testing.T) {
+	require.Zero(t, proquint.Confusability("", proquint.AcousticModel))
+	require.Zero(t, proquint.Confusability("bdfg", proquint.HammingModel))
+	require.InDelta(t, 0.5, proquint.Confusability("qw", proquint.KeyboardModel), 1e-9)
+
+	// Voiced and voiceless pairs are more confusable than the proquint
+	// consonants.
+	require.Greater(t,
+		proquint.Confusability("bpdtgkfvsz", proquint.AcousticModel),
+		proquint.Confusability("bdfghjklmnprstvz", proquint.AcousticModel),
+	)
+}
+
+func ExampleDistance() {
+	fmt.Printf("%.2f\n", proquint.Distance("lusab-babad", "lusab-babat", proquint.HammingModel))
+	fmt.Printf("%.2f\n", proquint.Distance("lusab-babad", "lusab-babat", proquint.AcousticModel))
+	fmt.Printf("%.2f\n", proquint.Distance("lusab-babad", "lusab-babaz", proquint.AcousticModel))
+	// Output:
+	// 1.00
+	// 0.33
+	// 0.33
+}
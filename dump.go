@@ -0,0 +1,165 @@
+package proquint
+
+import (
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+)
+
+// dumpLineSize is the number of bytes per line of a dump.
+const dumpLineSize = 16
+
+// dumpQuintsWidth is the width of the syllable column of a dump: 8
+// syllables separated by spaces with an additional space after the 4th
+// syllable.
+const dumpQuintsWidth = 8*5 + 7 + 1
+
+// Dump returns a string that contains a dump of the given data. The format
+// of the dump resembles the output of `hexdump -C`, with the hex bytes
+// replaced by proquint syllables. A final odd byte is written as half
+// syllable, see HalfSyllablePadding.
+//
+//	00000000  hodoj kudos kusos fadub  lanoz lajuj kojov libod  |Hello, proquint!|
+//	00000010  kutoh kib                                         |odd|
+func Dump(data []byte) string {
+	if len(data) == 0 {
+		return ""
+	}
+
+	var buf strings.Builder
+
+	dumper := Dumper(&buf)
+	_, _ = dumper.Write(data)
+	_ = dumper.Close()
+
+	return buf.String()
+}
+
+// Dumper returns a io.WriteCloser that writes a dump of all written data to
+// w. The format of the dump matches the output of Dump. Closing the Dumper
+// writes the final, incomplete line, if any.
+func Dumper(w io.Writer) io.WriteCloser {
+	return &dumper{w: w}
+}
+
+type dumper struct {
+	w      io.Writer
+	line   [dumpLineSize]byte
+	used   int
+	offset int
+	closed bool
+}
+
+func (d *dumper) Write(data []byte) (int, error) {
+	if d.closed {
+		return 0, fmt.Errorf("proquint: dumper closed")
+	}
+
+	for i := range data {
+		d.line[d.used] = data[i]
+		d.used++
+
+		if d.used == dumpLineSize {
+			err := d.flush()
+			if err != nil {
+				return i + 1, err
+			}
+		}
+	}
+
+	return len(data), nil
+}
+
+func (d *dumper) Close() error {
+	if d.closed {
+		return nil
+	}
+
+	d.closed = true
+
+	if d.used == 0 {
+		return nil
+	}
+
+	return d.flush()
+}
+
+// flush writes the buffered bytes as a single line of the dump.
+func (d *dumper) flush() error {
+	data := d.line[:d.used]
+
+	var quints strings.Builder
+	for i := 0; i < len(data); i += 2 {
+		if i == dumpLineSize/2 {
+			quints.WriteByte(' ')
+		}
+
+		if i > 0 {
+			quints.WriteByte(' ')
+		}
+
+		if i+1 == len(data) {
+			// A final odd byte is written as half syllable.
+			quints.WriteString(FromUint16(uint16(data[i]) << 8)[:3])
+			break
+		}
+
+		quints.WriteString(FromUint16(uint16(data[i])<<8 | uint16(data[i+1])))
+	}
+
+	ascii := make([]byte, len(data))
+	for i, b := range data {
+		ascii[i] = b
+		if b < 32 || b > 126 {
+			ascii[i] = '.'
+		}
+	}
+
+	_, err := fmt.Fprintf(d.w, "%08x  %-*s  |%s|\n", d.offset, dumpQuintsWidth, quints.String(), ascii)
+
+	d.offset += d.used
+	d.used = 0
+
+	return err
+}
+
+// Undump parses a dump created by Dump or Dumper and returns the dumped
+// data. The ASCII column is ignored, the offsets are verified to detect
+// missing lines.
+func Undump(dump string) ([]byte, error) {
+	var res []byte
+
+	for i, line := range strings.Split(dump, "\n") {
+		if strings.TrimSpace(line) == "" {
+			continue
+		}
+
+		// The ASCII column may contain any character, the syllables never
+		// contain a '|'.
+		line, _, _ = strings.Cut(line, "|")
+
+		fields := strings.Fields(line)
+		if len(fields) < 2 {
+			return nil, fmt.Errorf("line %d: invalid dump line", i+1)
+		}
+
+		offset, err := strconv.ParseUint(fields[0], 16, 64)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: invalid offset %q", i+1, fields[0])
+		}
+
+		if offset != uint64(len(res)) {
+			return nil, fmt.Errorf("line %d: offset %#x does not match expected offset %#x", i+1, offset, len(res))
+		}
+
+		data, err := ToBytes(strings.Join(fields[1:], ""), WithDecodingPadding(HalfSyllablePadding))
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %w", i+1, err)
+		}
+
+		res = append(res, data...)
+	}
+
+	return res, nil
+}
@@ -0,0 +1,150 @@
+package proquint_test
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestDump(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []byte
+
+		want string
+	}{
+		{
+			name: "empty",
+			in:   []byte{},
+
+			want: "",
+		},
+		{
+			name: "single line",
+			in:   []byte("Hello, proquint!"),
+
+			want: "00000000  hodoj kudos kusos fadub  lanoz lajuj kojov libod  |Hello, proquint!|\n",
+		},
+		{
+			name: "half syllable",
+			in:   []byte{0x7F, 0x00, 0x00, 0x01, 0xFF},
+
+			want: "00000000  lusab babad zus                                   |.....|\n",
+		},
+		{
+			name: "second line",
+			in:   []byte("Hello, proquint!odd"),
+
+			want: "00000000  hodoj kudos kusos fadub  lanoz lajuj kojov libod  |Hello, proquint!|\n" +
+				"00000010  kutoh kib                                         |odd|\n",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := proquint.Dump(test.in)
+			require.Equal(t, test.want, got)
+
+			res, err := proquint.Undump(got)
+			require.NoError(t, err)
+			require.Equal(t, test.in, append([]byte{}, res...))
+		})
+	}
+}
+
+func TestDumper(t *testing.T) {
+	data := make([]byte, 100)
+	for i := range data {
+		data[i] = byte(i * 7)
+	}
+
+	var buf bytes.Buffer
+
+	dumper := proquint.Dumper(&buf)
+
+	// Write in chunks, which do not align with the lines.
+	for i := 0; i < len(data); i += 7 {
+		n, err := dumper.Write(data[i:min(i+7, len(data))])
+		require.NoError(t, err)
+		require.Equal(t, min(7, len(data)-i), n)
+	}
+
+	require.NoError(t, dumper.Close())
+	require.NoError(t, dumper.Close())
+
+	_, err := dumper.Write([]byte{0x00})
+	require.Error(t, err)
+
+	require.Equal(t, proquint.Dump(data), buf.String())
+	require.Equal(t, 7, strings.Count(buf.String(), "\n"))
+}
+
+func TestUndump(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+
+		assertErr require.ErrorAssertionFunc
+		want      []byte
+	}{
+		{
+			name: "pipe in ASCII column",
+			in:   "00000000  lusab babad  |||||\n",
+
+			assertErr: require.NoError,
+			want:      []byte{0x7F, 0x00, 0x00, 0x01},
+		},
+		{
+			name: "without ASCII column",
+			in:   "00000000  lusab babad\n00000004  zus\n",
+
+			assertErr: require.NoError,
+			want:      []byte{0x7F, 0x00, 0x00, 0x01, 0xFF},
+		},
+		{
+			name: "missing line",
+			in:   "00000000  lusab babad\n00000008  zus\n",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "invalid offset",
+			in:   "0000000x  lusab babad\n",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "missing syllables",
+			in:   "00000000  |..|\n",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "invalid syllable",
+			in:   "00000000  lusab cabad\n",
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := proquint.Undump(test.in)
+			test.assertErr(t, err)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func ExampleDump() {
+	fmt.Print(proquint.Dump([]byte("Hello, proquint!odd")))
+	// Output:
+	// 00000000  hodoj kudos kusos fadub  lanoz lajuj kojov libod  |Hello, proquint!|
+	// 00000010  kutoh kib                                         |odd|
+}
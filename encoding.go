@@ -1,9 +1,12 @@
 package proquint
 
 import (
+	"encoding/binary"
 	"encoding/hex"
 	"fmt"
+	"math/big"
 	"strings"
+	"unsafe"
 )
 
 const (
@@ -16,70 +19,202 @@ const (
 	shiftForth  = 16 - 12
 )
 
-// FromUint16 encodes proquint from the provided uint16 argument.
-func FromUint16(in uint16) string {
+// Integer is the constraint for the integer types supported by Encode and
+// Decode. The number of proquint syllables is derived from the size of the
+// type, where 8 bit types are encoded as a single syllable. The platform
+// dependent types int and uint are not supported, since their size and
+// therefore the number of syllables differs between platforms.
+type Integer interface {
+	~uint8 | ~uint16 | ~uint32 | ~uint64 |
+		~int8 | ~int16 | ~int32 | ~int64
+}
+
+// Encode encodes proquint from the provided integer argument. Signed values
+// are encoded in their two's complement representation.
+func Encode[T Integer](in T, opts ...EncodingOption) string {
+	cfg := encodingConfig{
+		byteOrder: binary.BigEndian,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	size := int(unsafe.Sizeof(in))
+	ui64 := uint64(in)
+	if size < 8 {
+		// Remove the sign extension of negative signed values.
+		ui64 &= 1<<(8*size) - 1
+	}
+
+	ui64 = toByteOrder(ui64, size, cfg.byteOrder)
+
 	str := strings.Builder{}
+	writeTypeTag(&str, cfg)
+
+	for i := syllables(size) - 1; i >= 0; i-- {
+		if cfg.hyphens && str.Len() > 0 {
+			str.WriteByte('-')
+		}
+
+		writeSyllable(&str, uint16(ui64>>(16*i)))
+	}
+
+	return str.String()
+}
+
+// toByteOrder returns the integer of size bytes, whose big-endian
+// representation equals the representation of in in the given byte order.
+func toByteOrder(in uint64, size int, order binary.ByteOrder) uint64 {
+	buf := make([]byte, 8)
+
+	switch size {
+	case 2:
+		order.PutUint16(buf, uint16(in))
+		return uint64(binary.BigEndian.Uint16(buf))
+	case 4:
+		order.PutUint32(buf, uint32(in))
+		return uint64(binary.BigEndian.Uint32(buf))
+	case 8:
+		order.PutUint64(buf, in)
+		return binary.BigEndian.Uint64(buf)
+	default:
+		// A single byte does not have a byte order.
+		return in
+	}
+}
+
+// isLittleEndian reports, if the least significant byte is stored first in
+// the given byte order.
+func isLittleEndian(order binary.ByteOrder) bool {
+	return order.Uint16([]byte{0x01, 0x00}) == 0x0001
+}
+
+// syllables returns the number of proquint syllables needed to encode size
+// bytes.
+func syllables(size int) int {
+	return (size + 1) / 2
+}
+
+// writeTypeTag writes the type-tag syllable, if a type tag is configured.
+func writeTypeTag(str *strings.Builder, cfg encodingConfig) {
+	if !cfg.hasTypeTag {
+		return
+	}
 
+	writeSyllable(str, cfg.typeTag)
+}
+
+func writeSyllable(str *strings.Builder, in uint16) {
 	str.WriteByte(consonants[(in>>shiftFirst)&maskConsonant])
 	str.WriteByte(vowel[(in>>shiftSecond)&maskVowel])
 	str.WriteByte(consonants[(in>>shiftThird)&maskConsonant])
 	str.WriteByte(vowel[(in>>shiftForth)&maskVowel])
 	str.WriteByte(consonants[in&maskConsonant])
+}
 
-	return str.String()
+// FromUint16 encodes proquint from the provided uint16 argument.
+func FromUint16(in uint16, opts ...EncodingOption) string {
+	return Encode(in, opts...)
 }
 
 // FromInt16 encodes proquint from the provided int16 argument.
-func FromInt16(in int16) string {
-	return FromUint16(uint16(in))
+func FromInt16(in int16, opts ...EncodingOption) string {
+	return Encode(in, opts...)
 }
 
 // FromUint32 encodes proquint from the provided uint32 argument.
 func FromUint32(in uint32, opts ...EncodingOption) string {
-	cfg := encodingConfig{}
-
-	for _, opt := range opts {
-		opt(&cfg)
-	}
-
-	hyphens := ""
-	if cfg.hyphens {
-		hyphens = "-"
-	}
-
-	return FromUint16(uint16(in>>16)) + hyphens + FromUint16(uint16(in))
+	return Encode(in, opts...)
 }
 
 // FromInt32 encodes proquint from the provided int32 argument.
 func FromInt32(in int32, opts ...EncodingOption) string {
-	return FromUint32(uint32(in), opts...)
+	return Encode(in, opts...)
 }
 
 // FromUint64 encodes proquint from the provided uint64 argument.
 func FromUint64(in uint64, opts ...EncodingOption) string {
-	cfg := encodingConfig{}
+	return Encode(in, opts...)
+}
+
+// FromInt64 encodes proquint from the provided int64 argument.
+func FromInt64(in int64, opts ...EncodingOption) string {
+	return Encode(in, opts...)
+}
+
+// FromUint128 encodes proquint from the provided 128 bit unsigned integer,
+// given as its upper (hi) and lower (lo) 64 bits.
+func FromUint128(hi, lo uint64, opts ...EncodingOption) string {
+	cfg := encodingConfig{
+		byteOrder: binary.BigEndian,
+	}
 
 	for _, opt := range opts {
 		opt(&cfg)
 	}
 
-	hyphens := ""
-	if cfg.hyphens {
-		hyphens = "-"
+	if isLittleEndian(cfg.byteOrder) {
+		// The lower 64 bits are stored first.
+		hi, lo = lo, hi
+	}
+
+	buf := make([]byte, 16)
+	cfg.byteOrder.PutUint64(buf, hi)
+	cfg.byteOrder.PutUint64(buf[8:], lo)
+
+	str := strings.Builder{}
+	writeTypeTag(&str, cfg)
+
+	for i := 0; i < len(buf); i += 2 {
+		if cfg.hyphens && str.Len() > 0 {
+			str.WriteByte('-')
+		}
+
+		writeSyllable(&str, binary.BigEndian.Uint16(buf[i:]))
 	}
 
-	return FromUint16(uint16(in>>48)) + hyphens + FromUint16(uint16(in>>32)) + hyphens + FromUint16(uint16(in>>16)) + hyphens + FromUint16(uint16(in))
+	return str.String()
 }
 
-// FromInt64 encodes proquint from the provided int64 argument.
-func FromInt64(in int64, opts ...EncodingOption) string {
-	return FromUint64(uint64(in), opts...)
+// FromBigInt encodes proquint from the provided big.Int argument. The
+// absolute value is encoded in big-endian byte order with at least
+// minSyllables syllables, adding leading zero bytes as necessary. Negative
+// values are prefixed with a hyphen:
+//
+//	-babab-bahaf
+//
+// The options are passed on to FromBytes, so the same options need to be
+// passed to ToBigInt for decoding. Since the number of encoded bytes is
+// always even, ZeroBytePadding and FinalHyphenPadding never add a padding
+// byte.
+func FromBigInt(in *big.Int, minSyllables int, opts ...EncodingOption) (string, error) {
+	if in == nil {
+		return "", fmt.Errorf("big.Int must not be nil")
+	}
+
+	size := max(syllables(len(in.Bytes())), minSyllables, 1) * 2
+	abs := in.FillBytes(make([]byte, size))
+
+	quint, err := FromBytes(abs, opts...)
+	if err != nil {
+		return "", err
+	}
+
+	if in.Sign() < 0 {
+		quint = "-" + quint
+	}
+
+	return quint, nil
 }
 
 type encodingConfig struct {
-	hyphens            bool
-	padding            bool
-	paddingFinalHyphen bool
+	hyphens       bool
+	padding       Padding
+	byteOrder     binary.ByteOrder
+	wordByteOrder binary.ByteOrder
+	hasTypeTag    bool
+	typeTag       uint16
 }
 
 type EncodingOption func(*encodingConfig)
@@ -93,12 +228,51 @@ func WithHyphens() EncodingOption {
 	}
 }
 
+// WithEncodingByteOrder sets the byte order, in which integers are
+// encoded. The default is binary.BigEndian. For binary.LittleEndian,
+// the result is the same as encoding the little-endian in memory
+// representation of the integer with FromBytes:
+//
+//	FromUint32(0x7F000001, WithEncodingByteOrder(binary.LittleEndian)) // bahab-baduz
+func WithEncodingByteOrder(order binary.ByteOrder) EncodingOption {
+	return func(cfg *encodingConfig) {
+		cfg.byteOrder = order
+	}
+}
+
+// WithEncodingWordByteOrder sets the byte order of the 16 bit words, which
+// are formed by FromBytes from each pair of input bytes and encoded as a
+// syllable. The default is binary.BigEndian.
+func WithEncodingWordByteOrder(order binary.ByteOrder) EncodingOption {
+	return func(cfg *encodingConfig) {
+		cfg.wordByteOrder = order
+	}
+}
+
+// WithTypeTag prepends a type-tag syllable containing the given tag. The
+// tag is not validated while encoding, use RegisterType to register the
+// type for decoding with ToTagged.
+func WithTypeTag(tag uint16) EncodingOption {
+	return func(cfg *encodingConfig) {
+		cfg.hasTypeTag = true
+		cfg.typeTag = tag
+	}
+}
+
+// WithEncodingPadding sets the Padding used to encode an odd number of bytes.
+// The same Padding should be passed to WithDecodingPadding for decoding, use
+// a Codec to configure both at once.
+func WithEncodingPadding(padding Padding) EncodingOption {
+	return func(cfg *encodingConfig) {
+		cfg.padding = padding
+	}
+}
+
 // WithPadding allows to encode odd number of bytes by adding a single
 // 0x00 byte (padding byte) to the end of the input before encoding.
+// It is equivalent to WithEncodingPadding(ZeroBytePadding).
 func WithPadding() EncodingOption {
-	return func(cfg *encodingConfig) {
-		cfg.padding = true
-	}
+	return WithEncodingPadding(ZeroBytePadding)
 }
 
 // WithPaddingFinalHyphen allows to encode odd number of bytes by adding a single
@@ -107,26 +281,60 @@ func WithPadding() EncodingOption {
 // a final hyphen:
 //
 //	lusab-
+//
+// It is equivalent to WithEncodingPadding(FinalHyphenPadding) combined with
+// WithHyphens.
 func WithPaddingFinalHyphen() EncodingOption {
 	return func(cfg *encodingConfig) {
 		cfg.hyphens = true
-		cfg.padding = true
-		cfg.paddingFinalHyphen = true
+		cfg.padding = FinalHyphenPadding
 	}
 }
 
-func FromBytes(in []byte, opts ...EncodingOption) (string, error) {
+// DecodingOptionsFor returns the decoding options, which decode a proquint
+// encoded with the given encoding options, i.e. the matching byte orders,
+// type tag and padding.
+func DecodingOptionsFor(opts ...EncodingOption) []DecodingOption {
 	cfg := encodingConfig{}
 
 	for _, opt := range opts {
 		opt(&cfg)
 	}
 
-	padded := false
-	if cfg.padding && len(in)%2 == 1 {
-		// Odd number of bytes in input, compensate with 0x00 padding byte.
-		in = append(in, 0x00)
-		padded = true
+	var res []DecodingOption
+
+	if cfg.padding != nil {
+		res = append(res, WithDecodingPadding(cfg.padding))
+	}
+
+	if cfg.byteOrder != nil {
+		res = append(res, WithDecodingByteOrder(cfg.byteOrder))
+	}
+
+	if cfg.wordByteOrder != nil {
+		res = append(res, WithDecodingWordByteOrder(cfg.wordByteOrder))
+	}
+
+	if cfg.hasTypeTag {
+		res = append(res, WithDecodingTypeTag(cfg.typeTag))
+	}
+
+	return res
+}
+
+func FromBytes(in []byte, opts ...EncodingOption) (string, error) {
+	cfg := encodingConfig{
+		padding:       NoPadding,
+		wordByteOrder: binary.BigEndian,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	in, suffix, err := cfg.padding.Pad(in)
+	if err != nil {
+		return "", err
 	}
 
 	if len(in)%2 != 0 {
@@ -134,19 +342,22 @@ func FromBytes(in []byte, opts ...EncodingOption) (string, error) {
 	}
 
 	str := strings.Builder{}
+	writeTypeTag(&str, cfg)
 
 	for i := 0; i < len(in); i += 2 {
-		if cfg.hyphens && i > 0 {
+		if cfg.hyphens && str.Len() > 0 {
 			str.WriteByte('-')
 		}
 
-		str.WriteString(FromUint16(uint16(in[i])<<8 + uint16(in[i+1])))
+		writeSyllable(&str, cfg.wordByteOrder.Uint16(in[i:]))
 	}
 
-	if cfg.paddingFinalHyphen && padded {
+	if cfg.hyphens && str.Len() > 0 && suffix != "" && suffix[0] != '-' {
 		str.WriteByte('-')
 	}
 
+	str.WriteString(suffix)
+
 	return str.String(), nil
 }
 
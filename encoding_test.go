@@ -1,7 +1,9 @@
 package proquint_test
 
 import (
+	"encoding/binary"
 	"fmt"
+	"math/big"
 	"net/netip"
 	"testing"
 
@@ -338,6 +340,189 @@ func TestIntx(t *testing.T) {
 	require.Equal(t, "babab-babab-babab-bagav", proquint.FromInt64(int64(in), proquint.WithHyphens()))
 }
 
+type userID uint32
+
+func TestEncode(t *testing.T) {
+	require.Equal(t, "bagaz", proquint.Encode(uint8(0xCF)))
+	require.Equal(t, "bagaz", proquint.Encode(int8(-0x31)))
+	require.Equal(t, "bagav", proquint.Encode(uint16(0xCE)))
+	require.Equal(t, "zuzuz", proquint.Encode(int16(-1)))
+	require.Equal(t, "babab-bagav", proquint.Encode(uint32(0xCE), proquint.WithHyphens()))
+	require.Equal(t, "zuzuz-zuzuz", proquint.Encode(int32(-1), proquint.WithHyphens()))
+	require.Equal(t, "bababbababbababbagav", proquint.Encode(uint64(0xCE)))
+	require.Equal(t, "zuzuz-zuzuz-zuzuz-zuzuz", proquint.Encode(int64(-1), proquint.WithHyphens()))
+	require.Equal(t, "lusab-babad", proquint.Encode(userID(0x7F000001), proquint.WithHyphens()))
+}
+
+func TestFromUint128(t *testing.T) {
+	require.Equal(t, "bababbababbababbababbababbababbababbagav", proquint.FromUint128(0, 0xCE))
+	require.Equal(t, "kivaf-damur-zabal-hilup-pokum-figib-datoz-pugih", proquint.FromUint128(0x6782123bf00745fa, 0xa9b824d0136facd4, proquint.WithHyphens()))
+}
+
+func TestFromBigInt(t *testing.T) {
+	tests := []struct {
+		name         string
+		in           *big.Int
+		minSyllables int
+		opts         []proquint.EncodingOption
+
+		assertErr require.ErrorAssertionFunc
+		want      string
+	}{
+		{
+			name: "zero",
+			in:   big.NewInt(0),
+
+			assertErr: require.NoError,
+			want:      "babab",
+		},
+		{
+			name:         "zero with min syllables",
+			in:           big.NewInt(0),
+			minSyllables: 2,
+			opts: []proquint.EncodingOption{
+				proquint.WithHyphens(),
+			},
+
+			assertErr: require.NoError,
+			want:      "babab-babab",
+		},
+		{
+			name: "odd number of bytes",
+			in:   big.NewInt(0x7F0000),
+			opts: []proquint.EncodingOption{
+				proquint.WithHyphens(),
+			},
+
+			assertErr: require.NoError,
+			want:      "baduz-babab",
+		},
+		{
+			name:         "negative",
+			in:           big.NewInt(-0x0102),
+			minSyllables: 2,
+			opts: []proquint.EncodingOption{
+				proquint.WithHyphens(),
+			},
+
+			assertErr: require.NoError,
+			want:      "-babab-bahaf",
+		},
+		{
+			name: "exceeds min syllables",
+			in:   new(big.Int).Lsh(big.NewInt(1), 64),
+			opts: []proquint.EncodingOption{
+				proquint.WithHyphens(),
+			},
+
+			assertErr: require.NoError,
+			want:      "babad-babab-babab-babab-babab",
+		},
+		{
+			name: "length prefix padding",
+			in:   big.NewInt(0x7F000001),
+			opts: []proquint.EncodingOption{
+				proquint.WithHyphens(),
+				proquint.WithEncodingPadding(proquint.LengthPrefixPadding),
+			},
+
+			assertErr: require.NoError,
+			want:      "babah-lusab-babad",
+		},
+		{
+			name: "error - nil",
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			quint, err := proquint.FromBigInt(tc.in, tc.minSyllables, tc.opts...)
+			tc.assertErr(t, err)
+
+			require.Equal(t, tc.want, quint)
+		})
+	}
+}
+
+func TestEncodingByteOrder(t *testing.T) {
+	le := proquint.WithEncodingByteOrder(binary.LittleEndian)
+	be := proquint.WithEncodingByteOrder(binary.BigEndian)
+	hyphens := proquint.WithHyphens()
+
+	require.Equal(t, "bagaz", proquint.Encode(uint8(0xCF), le), "single byte has no byte order")
+	require.Equal(t, "damuh", proquint.FromUint16(0x1234, be))
+	require.Equal(t, "gibif", proquint.FromUint16(0x1234, le))
+	require.Equal(t, "gibif", proquint.FromInt16(0x1234, le))
+	require.Equal(t, "damuh-zabat", proquint.FromUint32(0x1234F00D, hyphens, be))
+	require.Equal(t, "bulub-gibif", proquint.FromUint32(0x1234F00D, hyphens, le))
+	require.Equal(t, "bahab-baduz", proquint.FromInt32(0x7F000001, hyphens, le))
+	require.Equal(t, "bahaf-basah-bihak-bisam", proquint.FromUint64(0x0102030405060708, hyphens, be))
+	require.Equal(t, "bobal-bimaj-bibag-bamad", proquint.FromUint64(0x0102030405060708, hyphens, le))
+	require.Equal(t, "bobal-bimaj-bibag-bamad", proquint.FromInt64(0x0102030405060708, hyphens, le))
+	require.Equal(t, "bahaf-basah-bihak-bisam-bohap-bosas-buhav-busib", proquint.FromUint128(0x0102030405060708, 0x090a0b0c0d0e0f10, hyphens, be))
+	require.Equal(t, "dabaz-bumat-bubar-boman-bobal-bimaj-bibag-bamad", proquint.FromUint128(0x0102030405060708, 0x090a0b0c0d0e0f10, hyphens, le))
+}
+
+func TestEncodingWordByteOrder(t *testing.T) {
+	tests := []struct {
+		name string
+		in   []byte
+		opts []proquint.EncodingOption
+
+		want string
+	}{
+		{
+			name: "big-endian",
+			in:   []byte{1, 2, 3, 4},
+			opts: []proquint.EncodingOption{
+				proquint.WithEncodingWordByteOrder(binary.BigEndian),
+			},
+
+			want: "bahaf-basah",
+		},
+		{
+			name: "little-endian",
+			in:   []byte{1, 2, 3, 4},
+			opts: []proquint.EncodingOption{
+				proquint.WithEncodingWordByteOrder(binary.LittleEndian),
+			},
+
+			want: "bamad-bibag",
+		},
+		{
+			name: "little-endian with zero byte padding",
+			in:   []byte{1, 2, 3},
+			opts: []proquint.EncodingOption{
+				proquint.WithEncodingWordByteOrder(binary.LittleEndian),
+				proquint.WithPadding(),
+			},
+
+			want: "bamad-babag",
+		},
+		{
+			name: "little-endian with half syllable padding",
+			in:   []byte{1, 2, 3},
+			opts: []proquint.EncodingOption{
+				proquint.WithEncodingWordByteOrder(binary.LittleEndian),
+				proquint.WithEncodingPadding(proquint.HalfSyllablePadding),
+			},
+
+			want: "bamad-bas",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			quint, err := proquint.FromBytes(tc.in, append(tc.opts, proquint.WithHyphens())...)
+			require.NoError(t, err)
+
+			require.Equal(t, tc.want, quint)
+		})
+	}
+}
+
 func TestHexToProquint(t *testing.T) {
 	tests := []struct {
 		name string
@@ -0,0 +1,218 @@
+package proquint
+
+import (
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/binary"
+	"fmt"
+	"hash"
+	"math/big"
+
+	// Register SHA-256 as default fingerprint hash.
+	_ "crypto/sha256"
+)
+
+type fingerprintConfig struct {
+	hash    crypto.Hash
+	sshWire bool
+	opts    []EncodingOption
+}
+
+type FingerprintOption func(*fingerprintConfig)
+
+// WithFingerprintHash sets the hash function used to create the fingerprint.
+// The hash function must be linked into the binary. The default is SHA-256.
+func WithFingerprintHash(h crypto.Hash) FingerprintOption {
+	return func(cfg *fingerprintConfig) {
+		cfg.hash = h
+	}
+}
+
+// WithSSHWireFormat hashes the public key in the SSH wire format (RFC 4253)
+// instead of the PKIX, ASN.1 DER form. This is the form used for
+// fingerprints by OpenSSH.
+func WithSSHWireFormat() FingerprintOption {
+	return func(cfg *fingerprintConfig) {
+		cfg.sshWire = true
+	}
+}
+
+// WithFingerprintEncodingOptions sets the options used to encode the
+// fingerprint.
+func WithFingerprintEncodingOptions(opts ...EncodingOption) FingerprintOption {
+	return func(cfg *fingerprintConfig) {
+		cfg.opts = opts
+	}
+}
+
+// Fingerprint returns the fingerprint of the public key as proquint with the
+// given number of syllables. The public key is hashed in its PKIX, ASN.1
+// DER form, see x509.MarshalPKIXPublicKey, or with WithSSHWireFormat in its
+// SSH wire format. Supported are ed25519.PublicKey, *rsa.PublicKey,
+// *ecdsa.PublicKey and, for the SSH wire format, keys implementing
+// Marshal() []byte, like ssh.PublicKey from golang.org/x/crypto/ssh.
+func Fingerprint(pub crypto.PublicKey, syllables int, opts ...FingerprintOption) (string, error) {
+	cfg := fingerprintConfig{
+		hash: crypto.SHA256,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if !cfg.hash.Available() {
+		return "", fmt.Errorf("hash function %s is not available", cfg.hash)
+	}
+
+	var (
+		wire []byte
+		err  error
+	)
+
+	if cfg.sshWire {
+		wire, err = marshalSSHPublicKey(pub)
+	} else {
+		wire, err = x509.MarshalPKIXPublicKey(pub)
+	}
+
+	if err != nil {
+		return "", err
+	}
+
+	h := cfg.hash.New()
+	_, _ = h.Write(wire)
+
+	return FromDigest(h, syllables, cfg.opts...)
+}
+
+// FromDigest encodes proquint from the digest of the hash with the given
+// number of syllables. The digest is truncated to its leading bytes, the
+// number of syllables must not exceed the size of the digest.
+func FromDigest(h hash.Hash, syllables int, opts ...EncodingOption) (string, error) {
+	err := validateDigestSyllables(h, syllables)
+	if err != nil {
+		return "", err
+	}
+
+	return FromBytes(h.Sum(nil)[:syllables*2], opts...)
+}
+
+func validateDigestSyllables(h hash.Hash, syllables int) error {
+	if syllables < 1 || syllables*2 > h.Size() {
+		return fmt.Errorf("invalid number of syllables %d, expect 1 to %d for digest of %d bytes", syllables, h.Size()/2, h.Size())
+	}
+
+	return nil
+}
+
+// Writer wraps a hash.Hash, such that Sum returns the truncated digest as
+// proquint text.
+type Writer struct {
+	hash.Hash
+
+	syllables int
+	opts      []EncodingOption
+}
+
+var _ hash.Hash = (*Writer)(nil)
+
+// NewWriter returns a new Writer for the hash, which encodes the digest with
+// the given number of syllables.
+func NewWriter(h hash.Hash, syllables int, opts ...EncodingOption) (*Writer, error) {
+	err := validateDigestSyllables(h, syllables)
+	if err != nil {
+		return nil, err
+	}
+
+	// Verify the options once, such that Sum does not need to fail.
+	_, err = FromBytes(make([]byte, syllables*2), opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Writer{
+		Hash:      h,
+		syllables: syllables,
+		opts:      opts,
+	}, nil
+}
+
+// Sum appends the proquint text of the truncated digest to b and returns
+// the resulting slice. It does not change the underlying hash state.
+func (w *Writer) Sum(b []byte) []byte {
+	quint, _ := FromBytes(w.Hash.Sum(nil)[:w.syllables*2], w.opts...)
+
+	return append(b, quint...)
+}
+
+// Size returns the number of bytes Sum will return.
+func (w *Writer) Size() int {
+	quint, _ := FromBytes(make([]byte, w.syllables*2), w.opts...)
+
+	return len(quint)
+}
+
+// marshalSSHPublicKey returns the public key in the SSH wire format.
+func marshalSSHPublicKey(pub crypto.PublicKey) ([]byte, error) {
+	switch pub := pub.(type) {
+	case interface{ Marshal() []byte }:
+		return pub.Marshal(), nil
+
+	case ed25519.PublicKey:
+		// RFC 8709
+		wire := appendSSHString(nil, []byte("ssh-ed25519"))
+		return appendSSHString(wire, pub), nil
+
+	case *rsa.PublicKey:
+		// RFC 4253
+		wire := appendSSHString(nil, []byte("ssh-rsa"))
+		wire = appendSSHMPInt(wire, big.NewInt(int64(pub.E)))
+		return appendSSHMPInt(wire, pub.N), nil
+
+	case *ecdsa.PublicKey:
+		// RFC 5656
+		var curve string
+
+		switch pub.Curve.Params().BitSize {
+		case 256:
+			curve = "nistp256"
+		case 384:
+			curve = "nistp384"
+		case 521:
+			curve = "nistp521"
+		default:
+			return nil, fmt.Errorf("unsupported ECDSA curve %s", pub.Curve.Params().Name)
+		}
+
+		point, err := pub.ECDH()
+		if err != nil {
+			return nil, err
+		}
+
+		wire := appendSSHString(nil, []byte("ecdsa-sha2-"+curve))
+		wire = appendSSHString(wire, []byte(curve))
+		return appendSSHString(wire, point.Bytes()), nil
+
+	default:
+		return nil, fmt.Errorf("unsupported public key type %T", pub)
+	}
+}
+
+func appendSSHString(b []byte, s []byte) []byte {
+	b = binary.BigEndian.AppendUint32(b, uint32(len(s)))
+	return append(b, s...)
+}
+
+func appendSSHMPInt(b []byte, n *big.Int) []byte {
+	mpint := n.Bytes()
+	if len(mpint) > 0 && mpint[0]&0x80 != 0 {
+		// Positive values with the most significant bit set need a leading
+		// zero byte.
+		mpint = append([]byte{0}, mpint...)
+	}
+
+	return appendSSHString(b, mpint)
+}
@@ -0,0 +1,217 @@
+package proquint_test
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/sha512"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/pem"
+	"fmt"
+	"hash"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+// Keys and fingerprints created with ssh-keygen.
+const (
+	// ssh-ed25519 AAAAC3NzaC1lZDI1NTE5AAAAIEHJW/qT20jYmN9938ijp9Egn41V3CHKVjYkLJzhzuoe
+	testEd25519SSH         = "AAAAC3NzaC1lZDI1NTE5AAAAIEHJW/qT20jYmN9938ijp9Egn41V3CHKVjYkLJzhzuoe"
+	testEd25519Fingerprint = "HWc8KH3oCQqfpv1Ohwsopnr9PFFaUNeT4fIEGoPWLQM"
+
+	testRSAPKIX = `-----BEGIN PUBLIC KEY-----
+MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQKBgQDAMFW/V8sCIgGmZOQuDNlrsbpz
+yRo7dVMQvgzOo3NBptE6csSQDhEdbIDtaginqq/azd/KzG6vRIN/wwbDWnOVrgsl
+TM5HE0KCU2Nz4hdQCK80zBmqUqdDdoDTnoSdmLpOQXuH6O/TtAgqOiat7aFI54G6
+Kqs2ju6H3TrNDwZuawIDAQAB
+-----END PUBLIC KEY-----`
+	testRSAFingerprint = "7nOyWX+dWdh+iRz3R+s+98YUfkCbmwjGCw/m9eBlqJQ"
+
+	testECDSAPKIX = `-----BEGIN PUBLIC KEY-----
+MFkwEwYHKoZIzj0CAQYIKoZIzj0DAQcDQgAEvQWmjh6X8+QpxQBkKwmtnJh8rPtf
+rB4RvXGglr7z1Uhfo9tXFQRVyGZuRtU6EB9OvN+PEiHnJkfDQt6+7eQ51g==
+-----END PUBLIC KEY-----`
+	testECDSAFingerprint = "aIWvLDaWcqgmcj5xDdBHMDZ1cKz5YgPATl9eqGioxsA"
+)
+
+type sshPublicKey []byte
+
+func (k sshPublicKey) Marshal() []byte {
+	return k
+}
+
+func TestFingerprint(t *testing.T) {
+	sshWire, err := base64.StdEncoding.DecodeString(testEd25519SSH)
+	require.NoError(t, err)
+
+	// The ed25519 key follows the key type "ssh-ed25519" in the SSH wire format.
+	ed25519Key := ed25519.PublicKey(sshWire[len(sshWire)-ed25519.PublicKeySize:])
+
+	rsaBlock, _ := pem.Decode([]byte(testRSAPKIX))
+	rsaKey, err := x509.ParsePKIXPublicKey(rsaBlock.Bytes)
+	require.NoError(t, err)
+
+	ecdsaBlock, _ := pem.Decode([]byte(testECDSAPKIX))
+	ecdsaKey, err := x509.ParsePKIXPublicKey(ecdsaBlock.Bytes)
+	require.NoError(t, err)
+
+	sha256Of := func(b []byte) []byte {
+		sum := sha256.Sum256(b)
+		return sum[:]
+	}
+
+	fromSSHFingerprint := func(fingerprint string) []byte {
+		digest, err := base64.RawStdEncoding.DecodeString(fingerprint)
+		require.NoError(t, err)
+		return digest
+	}
+
+	tests := []struct {
+		name string
+		pub  crypto.PublicKey
+		opts []proquint.FingerprintOption
+
+		wantDigest []byte
+	}{
+		{
+			name: "ed25519 - SSH",
+			pub:  ed25519Key,
+			opts: []proquint.FingerprintOption{proquint.WithSSHWireFormat()},
+
+			wantDigest: fromSSHFingerprint(testEd25519Fingerprint),
+		},
+		{
+			name: "ssh.PublicKey - SSH",
+			pub:  sshPublicKey(sshWire),
+			opts: []proquint.FingerprintOption{proquint.WithSSHWireFormat()},
+
+			wantDigest: fromSSHFingerprint(testEd25519Fingerprint),
+		},
+		{
+			name: "RSA - SSH",
+			pub:  rsaKey,
+			opts: []proquint.FingerprintOption{proquint.WithSSHWireFormat()},
+
+			wantDigest: fromSSHFingerprint(testRSAFingerprint),
+		},
+		{
+			name: "ECDSA - SSH",
+			pub:  ecdsaKey,
+			opts: []proquint.FingerprintOption{proquint.WithSSHWireFormat()},
+
+			wantDigest: fromSSHFingerprint(testECDSAFingerprint),
+		},
+		{
+			name: "RSA - PKIX",
+			pub:  rsaKey,
+
+			wantDigest: sha256Of(rsaBlock.Bytes),
+		},
+		{
+			name: "ECDSA - PKIX",
+			pub:  ecdsaKey,
+
+			wantDigest: sha256Of(ecdsaBlock.Bytes),
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := append(tc.opts, proquint.WithFingerprintEncodingOptions(proquint.WithHyphens()))
+
+			got, err := proquint.Fingerprint(tc.pub, 4, opts...)
+			require.NoError(t, err)
+
+			want, err := proquint.FromBytes(tc.wantDigest[:8], proquint.WithHyphens())
+			require.NoError(t, err)
+
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestFingerprintHash(t *testing.T) {
+	pub := ed25519.PublicKey(make([]byte, ed25519.PublicKeySize))
+	der, err := x509.MarshalPKIXPublicKey(pub)
+	require.NoError(t, err)
+
+	sum := sha512.Sum512(der)
+	want, err := proquint.FromBytes(sum[:32])
+	require.NoError(t, err)
+
+	got, err := proquint.Fingerprint(pub, 16, proquint.WithFingerprintHash(crypto.SHA512))
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = proquint.Fingerprint(pub, 16, proquint.WithFingerprintHash(crypto.MD4))
+	require.Error(t, err, "hash not available")
+
+	_, err = proquint.Fingerprint(pub, 17)
+	require.Error(t, err, "syllables exceed digest")
+
+	_, err = proquint.Fingerprint("invalid", 4)
+	require.Error(t, err, "unsupported key PKIX")
+
+	_, err = proquint.Fingerprint("invalid", 4, proquint.WithSSHWireFormat())
+	require.Error(t, err, "unsupported key SSH")
+}
+
+func TestFromDigest(t *testing.T) {
+	h := sha256.New()
+	_, _ = h.Write([]byte("proquint"))
+	sum := h.Sum(nil)
+
+	got, err := proquint.FromDigest(h, 2, proquint.WithHyphens())
+	require.NoError(t, err)
+
+	want, err := proquint.FromBytes(sum[:4], proquint.WithHyphens())
+	require.NoError(t, err)
+	require.Equal(t, want, got)
+
+	_, err = proquint.FromDigest(h, 0)
+	require.Error(t, err)
+
+	_, err = proquint.FromDigest(h, 17)
+	require.Error(t, err)
+}
+
+func TestWriter(t *testing.T) {
+	w, err := proquint.NewWriter(sha256.New(), 3, proquint.WithHyphens())
+	require.NoError(t, err)
+
+	var _ hash.Hash = w
+
+	_, _ = w.Write([]byte("pro"))
+	_, _ = w.Write([]byte("quint"))
+
+	sum := sha256.Sum256([]byte("proquint"))
+	want, err := proquint.FromBytes(sum[:6], proquint.WithHyphens())
+	require.NoError(t, err)
+
+	require.Equal(t, []byte("prefix:"+want), w.Sum([]byte("prefix:")))
+	require.Equal(t, len(want), w.Size())
+	require.Equal(t, sha256.BlockSize, w.BlockSize())
+
+	w.Reset()
+	empty := sha256.Sum256(nil)
+	want, err = proquint.FromBytes(empty[:6], proquint.WithHyphens())
+	require.NoError(t, err)
+	require.Equal(t, want, string(w.Sum(nil)))
+
+	_, err = proquint.NewWriter(sha256.New(), 17)
+	require.Error(t, err)
+}
+
+func ExampleFromDigest() {
+	h := sha256.New()
+	_, _ = h.Write([]byte("hello world"))
+
+	quint, _ := proquint.FromDigest(h, 4, proquint.WithHyphens())
+
+	fmt.Println(quint)
+	// Output: rojat-fivun-natat-gumam
+}
@@ -0,0 +1,106 @@
+package proquint
+
+import (
+	"fmt"
+	"net"
+	"strings"
+)
+
+// ouiSize is the size of the organizationally unique identifier (OUI) in
+// bytes, which identifies the vendor of a hardware address.
+const ouiSize = 3
+
+// FromHardwareAddr encodes proquint from the provided hardware address. A
+// 6 byte MAC address is encoded as 3 syllables, a 8 byte EUI-64 as 4
+// syllables:
+//
+//	bahaf-basah-bihak
+func FromHardwareAddr(in net.HardwareAddr, opts ...EncodingOption) (string, error) {
+	err := validateHardwareAddrLen(len(in))
+	if err != nil {
+		return "", err
+	}
+
+	return FromBytes(in, opts...)
+}
+
+// FromHardwareAddrOUI encodes proquint from the provided hardware address,
+// keeping the vendor part visible. The 3 byte organizationally unique
+// identifier (OUI) and the remaining bytes are encoded separately using
+// HalfSyllablePadding and separated by a dot. Therefore all hardware
+// addresses of the same vendor share the same prefix:
+//
+//	bahaf-bas.bibaj-bim
+func FromHardwareAddrOUI(in net.HardwareAddr, opts ...EncodingOption) (string, error) {
+	err := validateHardwareAddrLen(len(in))
+	if err != nil {
+		return "", err
+	}
+
+	opts = append(opts[:len(opts):len(opts)], WithEncodingPadding(HalfSyllablePadding))
+
+	oui, err := FromBytes(in[:ouiSize], opts...)
+	if err != nil {
+		return "", err
+	}
+
+	nic, err := FromBytes(in[ouiSize:], opts...)
+	if err != nil {
+		return "", err
+	}
+
+	return oui + "." + nic, nil
+}
+
+// ToHardwareAddr decodes a proquint string to a hardware address. Both, the
+// regular form created by FromHardwareAddr and the vendor preserving form
+// created by FromHardwareAddrOUI are accepted.
+func ToHardwareAddr(in string, opts ...DecodingOption) (net.HardwareAddr, error) {
+	oui, nic, found := strings.Cut(in, ".")
+	if !found {
+		res, err := ToBytes(in, opts...)
+		if err != nil {
+			return nil, err
+		}
+
+		err = validateHardwareAddrLen(len(res))
+		if err != nil {
+			return nil, err
+		}
+
+		return net.HardwareAddr(res), nil
+	}
+
+	opts = append(opts[:len(opts):len(opts)], WithDecodingPadding(HalfSyllablePadding))
+
+	res, err := ToBytes(oui, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) != ouiSize {
+		return nil, fmt.Errorf("invalid OUI with %d bytes, expect %d bytes", len(res), ouiSize)
+	}
+
+	nicBytes, err := ToBytes(nic, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	res = append(res, nicBytes...)
+
+	err = validateHardwareAddrLen(len(res))
+	if err != nil {
+		return nil, err
+	}
+
+	return net.HardwareAddr(res), nil
+}
+
+func validateHardwareAddrLen(size int) error {
+	if size != 6 && size != 8 {
+		return fmt.Errorf("invalid hardware address with %d bytes, expect 6 (MAC) or 8 (EUI-64) bytes", size)
+	}
+
+	return nil
+}
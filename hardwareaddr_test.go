@@ -0,0 +1,136 @@
+package proquint_test
+
+import (
+	"fmt"
+	"net"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestHardwareAddr(t *testing.T) {
+	tests := []struct {
+		name string
+		in   net.HardwareAddr
+
+		assertErr require.ErrorAssertionFunc
+		want      string
+		wantOUI   string
+	}{
+		{
+			name: "MAC",
+			in:   net.HardwareAddr{1, 2, 3, 4, 5, 6},
+
+			assertErr: require.NoError,
+			want:      "bahaf-basah-bihak",
+			wantOUI:   "bahaf-bas.bibaj-bim",
+		},
+		{
+			name: "EUI-64",
+			in:   net.HardwareAddr{1, 2, 3, 4, 5, 6, 7, 8},
+
+			assertErr: require.NoError,
+			want:      "bahaf-basah-bihak-bisam",
+			wantOUI:   "bahaf-bas.bibaj-bimal-bob",
+		},
+		{
+			name: "error - invalid length",
+			in:   net.HardwareAddr{1, 2, 3, 4},
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			quint, err := proquint.FromHardwareAddr(tc.in, proquint.WithHyphens())
+			tc.assertErr(t, err)
+			require.Equal(t, tc.want, quint)
+
+			quintOUI, err := proquint.FromHardwareAddrOUI(tc.in, proquint.WithHyphens())
+			tc.assertErr(t, err)
+			require.Equal(t, tc.wantOUI, quintOUI)
+
+			if err != nil {
+				return
+			}
+
+			got, err := proquint.ToHardwareAddr(quint)
+			require.NoError(t, err)
+			require.Equal(t, tc.in, got)
+
+			got, err = proquint.ToHardwareAddr(quintOUI)
+			require.NoError(t, err)
+			require.Equal(t, tc.in, got)
+		})
+	}
+}
+
+func TestToHardwareAddrErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+	}{
+		{
+			name: "invalid length",
+			in:   "bahaf-basah",
+		},
+		{
+			name: "invalid character",
+			in:   "bahaf-basah-biXak",
+		},
+		{
+			name: "OUI - invalid OUI length",
+			in:   "bahaf.bibaj-bim",
+		},
+		{
+			name: "OUI - invalid total length",
+			in:   "bahaf-bas.bibaj",
+		},
+		{
+			name: "OUI - invalid character in OUI",
+			in:   "bahaf-bXs.bibaj-bim",
+		},
+		{
+			name: "OUI - invalid character in NIC",
+			in:   "bahaf-bas.bibaj-bXm",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := proquint.ToHardwareAddr(tc.in)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestHardwareAddrOUIKeepsOptions(t *testing.T) {
+	mac, err := net.ParseMAC("00:1a:2b:3c:4d:5e")
+	require.NoError(t, err)
+
+	// Spare capacity of the options of the caller must not be overwritten.
+	encodingOpts := make([]proquint.EncodingOption, 1, 2)
+	encodingOpts[0] = proquint.WithHyphens()
+
+	quint, err := proquint.FromHardwareAddrOUI(mac, encodingOpts...)
+	require.NoError(t, err)
+	require.Nil(t, encodingOpts[:2][1])
+
+	decodingOpts := make([]proquint.DecodingOption, 0, 1)
+
+	got, err := proquint.ToHardwareAddr(quint, decodingOpts...)
+	require.NoError(t, err)
+	require.Equal(t, mac, got)
+	require.Nil(t, decodingOpts[:1][0])
+}
+
+func ExampleFromHardwareAddrOUI() {
+	mac, _ := net.ParseMAC("00:1a:2b:3c:4d:5e")
+	quint, _ := proquint.FromHardwareAddrOUI(mac, proquint.WithHyphens())
+
+	fmt.Println(quint)
+	// Output: babip-fos.gudat-jum
+}
@@ -0,0 +1,221 @@
+package ids
+
+import (
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+
+	"github.com/breml/proquint"
+)
+
+// ErrExhausted is returned by Allocator.Allocate, if no ID with the minimum
+// distance to all issued IDs has been found within the maximum number of
+// attempts.
+var ErrExhausted = errors.New("no ID with minimum distance found")
+
+// IDSet is the backing set of IDs issued by an Allocator.
+type IDSet interface {
+	// Add adds the ID to the set.
+	Add(id string) error
+
+	// Range calls fn for each ID in the set until fn returns false.
+	Range(fn func(id string) bool) error
+}
+
+// MemorySet is an in-memory IDSet. It is safe for concurrent use.
+type MemorySet struct {
+	mu  sync.RWMutex
+	ids map[string]struct{}
+}
+
+// NewMemorySet returns a MemorySet containing the given IDs.
+func NewMemorySet(ids ...string) *MemorySet {
+	s := &MemorySet{
+		ids: make(map[string]struct{}, len(ids)),
+	}
+
+	for _, id := range ids {
+		s.ids[id] = struct{}{}
+	}
+
+	return s
+}
+
+// Add adds the ID to the set.
+func (s *MemorySet) Add(id string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	s.ids[id] = struct{}{}
+
+	return nil
+}
+
+// Range calls fn for each ID in the set until fn returns false. fn must not
+// call Add.
+func (s *MemorySet) Range(fn func(id string) bool) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	for id := range s.ids {
+		if !fn(id) {
+			break
+		}
+	}
+
+	return nil
+}
+
+// Len returns the number of IDs in the set.
+func (s *MemorySet) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return len(s.ids)
+}
+
+// Allocator issues random proquint IDs with a minimum distance to all IDs
+// already issued, such that IDs are not easily confused with each other.
+//
+// An Allocator is safe for concurrent use. If the IDSet is shared between
+// multiple Allocators, e.g. in a database, the IDSet needs to reject
+// conflicting IDs in Add.
+type Allocator struct {
+	set         IDSet
+	syllables   int
+	minDistance float64
+	distance    func(a, b string) float64
+	maxAttempts int
+	random      io.Reader
+	opts        []proquint.EncodingOption
+
+	mu sync.Mutex
+}
+
+// AllocatorOption configures an Allocator.
+type AllocatorOption func(*Allocator)
+
+// WithAllocatorSyllables sets the number of syllables of the issued IDs.
+// The default is 2 syllables (32 bit).
+func WithAllocatorSyllables(syllables int) AllocatorOption {
+	return func(a *Allocator) {
+		a.syllables = syllables
+	}
+}
+
+// WithMinDistance sets the minimum distance of a new ID to all issued IDs.
+// The default is 2, meaning two IDs differ in at least 2 letters.
+func WithMinDistance(distance float64) AllocatorOption {
+	return func(a *Allocator) {
+		a.minDistance = distance
+	}
+}
+
+// WithDistance sets the function measuring the distance between two IDs,
+// e.g. proquint.Distance with proquint.AcousticModel. The default is
+// proquint.Distance with proquint.HammingModel, the number of differing
+// letters.
+func WithDistance(distance func(a, b string) float64) AllocatorOption {
+	return func(a *Allocator) {
+		a.distance = distance
+	}
+}
+
+// WithMaxAttempts sets the maximum number of random IDs tried by Allocate.
+// The default is 100.
+func WithMaxAttempts(attempts int) AllocatorOption {
+	return func(a *Allocator) {
+		a.maxAttempts = attempts
+	}
+}
+
+// WithAllocatorRandom sets the source of the random bytes. The default is
+// crypto/rand.Reader.
+func WithAllocatorRandom(random io.Reader) AllocatorOption {
+	return func(a *Allocator) {
+		a.random = random
+	}
+}
+
+// WithAllocatorEncodingOptions sets the options used to encode the issued
+// IDs.
+func WithAllocatorEncodingOptions(opts ...proquint.EncodingOption) AllocatorOption {
+	return func(a *Allocator) {
+		a.opts = opts
+	}
+}
+
+// NewAllocator returns a new Allocator issuing IDs, which are added to set.
+func NewAllocator(set IDSet, opts ...AllocatorOption) (*Allocator, error) {
+	a := &Allocator{
+		set:         set,
+		syllables:   2,
+		minDistance: 2,
+		distance:    hamming,
+		maxAttempts: 100,
+		random:      rand.Reader,
+	}
+
+	for _, opt := range opts {
+		opt(a)
+	}
+
+	if a.syllables < 1 {
+		return nil, fmt.Errorf("invalid number of syllables %d", a.syllables)
+	}
+
+	if a.maxAttempts < 1 {
+		return nil, fmt.Errorf("invalid number of attempts %d", a.maxAttempts)
+	}
+
+	return a, nil
+}
+
+// Allocate returns a new random ID with at least the minimum distance to all
+// IDs in the set and adds it to the set.
+func (a *Allocator) Allocate() (string, error) {
+	a.mu.Lock()
+	defer a.mu.Unlock()
+
+	random := make([]byte, 2*a.syllables)
+
+	for range a.maxAttempts {
+		_, err := io.ReadFull(a.random, random)
+		if err != nil {
+			return "", fmt.Errorf("failed to read random bytes: %w", err)
+		}
+
+		id, err := proquint.FromBytes(random, a.opts...)
+		if err != nil {
+			return "", err
+		}
+
+		ok := true
+		err = a.set.Range(func(issued string) bool {
+			ok = a.distance(id, issued) >= a.minDistance
+			return ok
+		})
+		if err != nil {
+			return "", err
+		}
+
+		if !ok {
+			continue
+		}
+
+		err = a.set.Add(id)
+		if err != nil {
+			return "", err
+		}
+
+		return id, nil
+	}
+
+	return "", fmt.Errorf("%w after %d attempts", ErrExhausted, a.maxAttempts)
+}
+
+func hamming(a, b string) float64 {
+	return proquint.Distance(a, b, proquint.HammingModel)
+}
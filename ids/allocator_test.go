@@ -0,0 +1,182 @@
+package ids_test
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+	"github.com/breml/proquint/ids"
+)
+
+func TestAllocator(t *testing.T) {
+	random := []byte{
+		0x7F, 0x00, 0x00, 0x0D, // lusab-babat, distance 1
+		0x7F, 0x00, 0xFF, 0xFF, // lusab-zuzuz, distance 5
+	}
+
+	tests := []struct {
+		name string
+		opts []ids.AllocatorOption
+
+		assertErr require.ErrorAssertionFunc
+		want      string
+	}{
+		{
+			name: "default minimum distance",
+
+			assertErr: require.NoError,
+			want:      "lusab-zuzuz",
+		},
+		{
+			name: "minimum distance 1",
+			opts: []ids.AllocatorOption{ids.WithMinDistance(1)},
+
+			assertErr: require.NoError,
+			want:      "lusab-babat",
+		},
+		{
+			name: "acoustic distance",
+			opts: []ids.AllocatorOption{
+				ids.WithMinDistance(0.5),
+				ids.WithDistance(func(a, b string) float64 {
+					return proquint.Distance(a, b, proquint.AcousticModel)
+				}),
+			},
+
+			assertErr: require.NoError,
+			want:      "lusab-zuzuz",
+		},
+		{
+			name: "custom distance",
+			opts: []ids.AllocatorOption{ids.WithDistance(func(a, b string) float64 {
+				// Only the first syllable counts.
+				if a[:5] == b[:5] {
+					return 0
+				}
+
+				return 5
+			}), ids.WithMaxAttempts(2)},
+
+			assertErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorIs(t, err, ids.ErrExhausted)
+			},
+		},
+		{
+			name: "exhausted",
+			opts: []ids.AllocatorOption{ids.WithMaxAttempts(1)},
+
+			assertErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorIs(t, err, ids.ErrExhausted)
+			},
+		},
+		{
+			name: "not enough random bytes",
+			opts: []ids.AllocatorOption{ids.WithMinDistance(10)},
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			set := ids.NewMemorySet("lusab-babad")
+
+			opts := append([]ids.AllocatorOption{
+				ids.WithAllocatorRandom(bytes.NewReader(random)),
+				ids.WithAllocatorEncodingOptions(proquint.WithHyphens()),
+			}, test.opts...)
+
+			allocator, err := ids.NewAllocator(set, opts...)
+			require.NoError(t, err)
+
+			got, err := allocator.Allocate()
+			test.assertErr(t, err)
+
+			require.Equal(t, test.want, got)
+
+			if got != "" {
+				require.Equal(t, 2, set.Len())
+			}
+		})
+	}
+}
+
+func TestAllocatorMinDistance(t *testing.T) {
+	set := ids.NewMemorySet()
+
+	allocator, err := ids.NewAllocator(set, ids.WithAllocatorSyllables(1), ids.WithMinDistance(3))
+	require.NoError(t, err)
+
+	var issued []string
+	for range 50 {
+		id, err := allocator.Allocate()
+		require.NoError(t, err)
+
+		issued = append(issued, id)
+	}
+
+	require.Equal(t, 50, set.Len())
+
+	for i := range issued {
+		for j := range i {
+			distance := 0
+			for k := range 5 {
+				if issued[i][k] != issued[j][k] {
+					distance++
+				}
+			}
+
+			require.GreaterOrEqual(t, distance, 3, "%s and %s", issued[i], issued[j])
+		}
+	}
+}
+
+// failingSet is an IDSet failing on Add, e.g. due to a conflicting ID in a
+// shared database.
+type failingSet struct {
+	*ids.MemorySet
+}
+
+func (failingSet) Add(string) error {
+	return errors.New("conflict")
+}
+
+func TestAllocatorSetError(t *testing.T) {
+	allocator, err := ids.NewAllocator(failingSet{ids.NewMemorySet()})
+	require.NoError(t, err)
+
+	_, err = allocator.Allocate()
+	require.ErrorContains(t, err, "conflict")
+}
+
+func TestNewAllocatorInvalid(t *testing.T) {
+	_, err := ids.NewAllocator(ids.NewMemorySet(), ids.WithAllocatorSyllables(0))
+	require.Error(t, err)
+
+	_, err = ids.NewAllocator(ids.NewMemorySet(), ids.WithMaxAttempts(0))
+	require.Error(t, err)
+}
+
+func TestMemorySetRange(t *testing.T) {
+	set := ids.NewMemorySet("lusab-babad", "safom-babad", "lusab-babad")
+	require.Equal(t, 2, set.Len())
+
+	var got []string
+	err := set.Range(func(id string) bool {
+		got = append(got, id)
+		return true
+	})
+	require.NoError(t, err)
+	require.ElementsMatch(t, []string{"lusab-babad", "safom-babad"}, got)
+
+	count := 0
+	err = set.Range(func(string) bool {
+		count++
+		return false
+	})
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+}
@@ -0,0 +1,205 @@
+package ids
+
+import (
+	"crypto/rand"
+	"encoding/binary"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/breml/proquint"
+)
+
+// Generator generates time-sortable proquint IDs. The leading syllables
+// contain the milliseconds since the epoch, the remaining syllables contain
+// random bytes. Since the proquint consonants and vowels are in alphabetical
+// order, IDs created in different milliseconds sort by their creation time.
+// With WithMonotonic, IDs created within the same millisecond sort by their
+// creation order, too. Encoding the IDs with
+// proquint.WithEncodingWordByteOrder(binary.LittleEndian) swaps the bytes
+// within each syllable and the IDs no longer sort by their creation time.
+//
+// A Generator is safe for concurrent use.
+type Generator struct {
+	epoch         time.Time
+	syllables     int
+	timeSyllables int
+	monotonic     bool
+	now           func() time.Time
+	random        io.Reader
+	opts          []proquint.EncodingOption
+	decodeOpts    []proquint.DecodingOption
+
+	mu         sync.Mutex
+	lastMillis uint64
+	last       []byte
+}
+
+// GeneratorOption configures a Generator.
+type GeneratorOption func(*Generator)
+
+// WithEpoch sets the epoch, from which the milliseconds are counted.
+// The default is the Unix epoch.
+func WithEpoch(epoch time.Time) GeneratorOption {
+	return func(g *Generator) {
+		g.epoch = epoch
+	}
+}
+
+// WithSyllables sets the total number of syllables of the generated IDs.
+// The default is 8 syllables (128 bit).
+func WithSyllables(syllables int) GeneratorOption {
+	return func(g *Generator) {
+		g.syllables = syllables
+	}
+}
+
+// WithTimeSyllables sets the number of leading syllables containing the
+// timestamp. The default is 3 syllables (48 bit), which lasts for more than
+// 8900 years after the epoch.
+func WithTimeSyllables(syllables int) GeneratorOption {
+	return func(g *Generator) {
+		g.timeSyllables = syllables
+	}
+}
+
+// WithMonotonic increments the random part of the previous ID by one for IDs
+// created within the same millisecond (or if the clock moves backwards),
+// instead of drawing new random bytes.
+func WithMonotonic() GeneratorOption {
+	return func(g *Generator) {
+		g.monotonic = true
+	}
+}
+
+// WithClock sets the function returning the current time. The default is
+// time.Now.
+func WithClock(now func() time.Time) GeneratorOption {
+	return func(g *Generator) {
+		g.now = now
+	}
+}
+
+// WithRandom sets the source of the random bytes. The default is
+// crypto/rand.Reader.
+func WithRandom(random io.Reader) GeneratorOption {
+	return func(g *Generator) {
+		g.random = random
+	}
+}
+
+// WithEncodingOptions sets the options used to encode the generated IDs.
+// ExtractTime decodes the IDs with the matching decoding options. A little
+// endian word byte order breaks the sort order of the IDs, see Generator.
+func WithEncodingOptions(opts ...proquint.EncodingOption) GeneratorOption {
+	return func(g *Generator) {
+		g.opts = opts
+	}
+}
+
+// NewGenerator returns a new Generator for time-sortable proquint IDs.
+func NewGenerator(opts ...GeneratorOption) (*Generator, error) {
+	g := &Generator{
+		epoch:         time.UnixMilli(0),
+		syllables:     8,
+		timeSyllables: 3,
+		now:           time.Now,
+		random:        rand.Reader,
+	}
+
+	for _, opt := range opts {
+		opt(g)
+	}
+
+	g.decodeOpts = proquint.DecodingOptionsFor(g.opts...)
+
+	if g.timeSyllables < 1 || g.timeSyllables > 3 {
+		return nil, fmt.Errorf("invalid number of time syllables %d, expect 1 to 3", g.timeSyllables)
+	}
+
+	if g.syllables <= g.timeSyllables {
+		return nil, fmt.Errorf("number of syllables %d must be greater than number of time syllables %d", g.syllables, g.timeSyllables)
+	}
+
+	return g, nil
+}
+
+// New returns a new time-sortable proquint ID.
+func (g *Generator) New() (string, error) {
+	now := g.now()
+	if now.Before(g.epoch) {
+		return "", fmt.Errorf("current time %s is before epoch %s", now, g.epoch)
+	}
+
+	millis := uint64(now.UnixMilli() - g.epoch.UnixMilli())
+	if millis >= 1<<(16*g.timeSyllables) {
+		return "", fmt.Errorf("timestamp overflows %d time syllables", g.timeSyllables)
+	}
+
+	id := make([]byte, g.syllables*2)
+	timeSize := g.timeSyllables * 2
+
+	g.mu.Lock()
+	defer g.mu.Unlock()
+
+	if g.monotonic && g.last != nil && millis <= g.lastMillis {
+		// Same millisecond or clock moved backwards, keep the timestamp of the
+		// previous ID and increment its random part.
+		millis = g.lastMillis
+		next := append([]byte(nil), g.last...)
+		if !increment(next) {
+			return "", fmt.Errorf("random part exhausted within millisecond %d", millis)
+		}
+
+		g.last = next
+	} else {
+		g.last = make([]byte, len(id)-timeSize)
+		_, err := io.ReadFull(g.random, g.last)
+		if err != nil {
+			return "", fmt.Errorf("failed to read random bytes: %w", err)
+		}
+	}
+
+	g.lastMillis = millis
+
+	var ts [8]byte
+	binary.BigEndian.PutUint64(ts[:], millis)
+	copy(id, ts[8-timeSize:])
+	copy(id[timeSize:], g.last)
+
+	return proquint.FromBytes(id, g.opts...)
+}
+
+// ExtractTime returns the creation time of an ID generated by this
+// Generator. The ID is decoded with the decoding options matching the
+// encoding options of the Generator.
+func (g *Generator) ExtractTime(id string) (time.Time, error) {
+	res, err := proquint.ToBytes(id, g.decodeOpts...)
+	if err != nil {
+		return time.Time{}, err
+	}
+
+	if len(res) != g.syllables*2 {
+		return time.Time{}, fmt.Errorf("invalid ID with %d syllables, expect %d", len(res)/2, g.syllables)
+	}
+
+	var ts [8]byte
+	copy(ts[8-g.timeSyllables*2:], res[:g.timeSyllables*2])
+	millis := binary.BigEndian.Uint64(ts[:])
+
+	return time.UnixMilli(g.epoch.UnixMilli() + int64(millis)), nil
+}
+
+// increment increments the big-endian number in b by one. It returns false,
+// if the number overflows.
+func increment(b []byte) bool {
+	for i := len(b) - 1; i >= 0; i-- {
+		b[i]++
+		if b[i] != 0 {
+			return true
+		}
+	}
+
+	return false
+}
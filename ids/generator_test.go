@@ -0,0 +1,260 @@
+package ids_test
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"slices"
+	"sync"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+	"github.com/breml/proquint/ids"
+)
+
+type fakeClock struct {
+	mu  sync.Mutex
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	return c.now
+}
+
+func (c *fakeClock) Advance(d time.Duration) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.now = c.now.Add(d)
+}
+
+func TestGenerator(t *testing.T) {
+	clock := &fakeClock{now: time.UnixMilli(0x0102030405)}
+
+	g, err := ids.NewGenerator(
+		ids.WithClock(clock.Now),
+		ids.WithRandom(bytes.NewReader(bytes.Repeat([]byte{0xFF}, 100))),
+		ids.WithEncodingOptions(proquint.WithHyphens()),
+		ids.WithSyllables(4),
+	)
+	require.NoError(t, err)
+
+	id, err := g.New()
+	require.NoError(t, err)
+	require.Equal(t, "babad-bamag-bibaj-zuzuz", id)
+
+	ts, err := g.ExtractTime(id)
+	require.NoError(t, err)
+	require.Equal(t, clock.Now(), ts)
+
+	_, err = g.ExtractTime("bahaf-basah")
+	require.Error(t, err)
+}
+
+func TestGeneratorExtractTimeEncodingOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []proquint.EncodingOption
+	}{
+		{
+			name: "little-endian words",
+			opts: []proquint.EncodingOption{proquint.WithEncodingWordByteOrder(binary.LittleEndian)},
+		},
+		{
+			name: "type tag",
+			opts: []proquint.EncodingOption{proquint.WithTypeTag(7), proquint.WithHyphens()},
+		},
+		{
+			name: "length prefix padding",
+			opts: []proquint.EncodingOption{proquint.WithEncodingPadding(proquint.LengthPrefixPadding)},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			clock := &fakeClock{now: time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)}
+
+			g, err := ids.NewGenerator(ids.WithClock(clock.Now), ids.WithEncodingOptions(tc.opts...))
+			require.NoError(t, err)
+
+			id, err := g.New()
+			require.NoError(t, err)
+
+			ts, err := g.ExtractTime(id)
+			require.NoError(t, err)
+			require.True(t, clock.Now().Equal(ts), "want %s, got %s", clock.Now(), ts)
+		})
+	}
+}
+
+func TestGeneratorEpoch(t *testing.T) {
+	epoch := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: epoch.Add(1234 * time.Millisecond)}
+
+	g, err := ids.NewGenerator(
+		ids.WithEpoch(epoch),
+		ids.WithClock(clock.Now),
+		ids.WithRandom(bytes.NewReader(make([]byte, 100))),
+		ids.WithTimeSyllables(2),
+		ids.WithSyllables(3),
+	)
+	require.NoError(t, err)
+
+	id, err := g.New()
+	require.NoError(t, err)
+	require.Equal(t, proquint.FromUint32(1234)+"babab", id)
+
+	ts, err := g.ExtractTime(id)
+	require.NoError(t, err)
+	require.True(t, clock.Now().Equal(ts))
+
+	clock.now = epoch.Add(-time.Millisecond)
+	_, err = g.New()
+	require.Error(t, err, "before epoch")
+
+	clock.now = epoch.Add(1 << 32 * time.Millisecond)
+	_, err = g.New()
+	require.Error(t, err, "timestamp overflow")
+}
+
+func TestGeneratorMonotonic(t *testing.T) {
+	clock := &fakeClock{now: time.UnixMilli(1000)}
+
+	g, err := ids.NewGenerator(
+		ids.WithClock(clock.Now),
+		ids.WithRandom(bytes.NewReader(append([]byte{0x00, 0xFE}, bytes.Repeat([]byte{0x10}, 100)...))),
+		ids.WithMonotonic(),
+		ids.WithTimeSyllables(1),
+		ids.WithSyllables(2),
+	)
+	require.NoError(t, err)
+
+	var got []string
+	for range 2 {
+		id, err := g.New()
+		require.NoError(t, err)
+
+		got = append(got, id)
+	}
+
+	// Clock moves backwards, keep the previous timestamp.
+	clock.Advance(-time.Millisecond)
+
+	id, err := g.New()
+	require.NoError(t, err)
+	got = append(got, id)
+
+	require.Equal(t, []string{
+		proquint.FromUint32(1000<<16 | 0x00FE),
+		proquint.FromUint32(1000<<16 | 0x00FF),
+		proquint.FromUint32(1000<<16 | 0x0100),
+	}, got)
+
+	clock.Advance(2 * time.Millisecond)
+
+	id, err = g.New()
+	require.NoError(t, err)
+	require.Equal(t, proquint.FromUint32(1001<<16|0x1010), id)
+}
+
+func TestGeneratorMonotonicExhausted(t *testing.T) {
+	clock := &fakeClock{now: time.UnixMilli(1000)}
+
+	g, err := ids.NewGenerator(
+		ids.WithClock(clock.Now),
+		ids.WithRandom(bytes.NewReader([]byte{0xFF, 0xFF})),
+		ids.WithMonotonic(),
+		ids.WithTimeSyllables(1),
+		ids.WithSyllables(2),
+	)
+	require.NoError(t, err)
+
+	_, err = g.New()
+	require.NoError(t, err)
+
+	_, err = g.New()
+	require.Error(t, err)
+
+	_, err = g.New()
+	require.Error(t, err, "exhaustion must not wrap around")
+}
+
+func TestGeneratorSortOrder(t *testing.T) {
+	clock := &fakeClock{now: time.UnixMilli(1_700_000_000_000)}
+
+	g, err := ids.NewGenerator(
+		ids.WithClock(clock.Now),
+		ids.WithMonotonic(),
+		ids.WithEncodingOptions(proquint.WithHyphens()),
+	)
+	require.NoError(t, err)
+
+	var got []string
+	for i := range 1000 {
+		if i%10 == 0 {
+			clock.Advance(time.Millisecond)
+		}
+
+		id, err := g.New()
+		require.NoError(t, err)
+
+		got = append(got, id)
+	}
+
+	require.True(t, slices.IsSorted(got))
+}
+
+func TestGeneratorConcurrent(t *testing.T) {
+	g, err := ids.NewGenerator(ids.WithMonotonic())
+	require.NoError(t, err)
+
+	var (
+		wg   sync.WaitGroup
+		mu   sync.Mutex
+		seen = map[string]bool{}
+	)
+
+	for range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for range 100 {
+				id, err := g.New()
+				require.NoError(t, err)
+
+				mu.Lock()
+				require.False(t, seen[id], "duplicate id %s", id)
+				seen[id] = true
+				mu.Unlock()
+			}
+		}()
+	}
+
+	wg.Wait()
+	require.Len(t, seen, 800)
+}
+
+func TestNewGeneratorErrors(t *testing.T) {
+	_, err := ids.NewGenerator(ids.WithTimeSyllables(0))
+	require.Error(t, err)
+
+	_, err = ids.NewGenerator(ids.WithTimeSyllables(4))
+	require.Error(t, err)
+
+	_, err = ids.NewGenerator(ids.WithTimeSyllables(3), ids.WithSyllables(3))
+	require.Error(t, err)
+
+	g, err := ids.NewGenerator(ids.WithRandom(iotest.ErrReader(errors.New("random failed"))))
+	require.NoError(t, err)
+
+	_, err = g.New()
+	require.Error(t, err)
+}
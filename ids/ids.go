@@ -0,0 +1,83 @@
+// Package ids converts the canonical text form of well-known ID formats to
+// and from proquints.
+//
+// The conversion is exact, the canonical text form of an ID decoded from a
+// proquint is always equal to the canonical text form of the original ID.
+// Since the proquint consonants and vowels are in alphabetical order, the
+// lexicographic order of proquints equals the order of the encoded bytes.
+// Therefore the sort order of the sortable formats ULID, KSUID, xid and
+// ObjectID is preserved, as long as the same options are used for encoding.
+//
+// Additionally, the package provides generators for new proquint IDs.
+package ids
+
+import (
+	"fmt"
+	"math/big"
+	"strings"
+
+	"github.com/breml/proquint"
+)
+
+// fromBytes encodes the decoded bytes of an ID of the given format.
+func fromBytes(format string, in []byte, err error, opts []proquint.EncodingOption) (string, error) {
+	if err != nil {
+		return "", fmt.Errorf("invalid %s: %w", format, err)
+	}
+
+	return proquint.FromBytes(in, opts...)
+}
+
+// toBytes decodes a proquint to the bytes of an ID of the given format and
+// validates the size.
+func toBytes(format string, in string, size int, opts []proquint.DecodingOption) ([]byte, error) {
+	res, err := proquint.ToBytes(in, opts...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(res) != size {
+		return nil, fmt.Errorf("invalid %s with %d bytes, expect %d bytes", format, len(res), size)
+	}
+
+	return res, nil
+}
+
+// decodeBase decodes a number in the given base with the digits of the
+// alphabet into a big-endian byte slice of the given size.
+func decodeBase(in string, alphabet string, size int) ([]byte, error) {
+	base := big.NewInt(int64(len(alphabet)))
+	n := new(big.Int)
+
+	for _, c := range []byte(in) {
+		digit := strings.IndexByte(alphabet, c)
+		if digit < 0 {
+			return nil, fmt.Errorf("invalid character %q", string([]byte{c}))
+		}
+
+		n.Mul(n, base)
+		n.Add(n, big.NewInt(int64(digit)))
+	}
+
+	if n.BitLen() > size*8 {
+		return nil, fmt.Errorf("value overflows %d bytes", size)
+	}
+
+	return n.FillBytes(make([]byte, size)), nil
+}
+
+// encodeBase encodes the big-endian byte slice as number in the given base
+// with the digits of the alphabet, left padded to length digits.
+func encodeBase(in []byte, alphabet string, length int) string {
+	base := big.NewInt(int64(len(alphabet)))
+	n := new(big.Int).SetBytes(in)
+	digit := new(big.Int)
+
+	res := make([]byte, length)
+	for i := length - 1; i >= 0; i-- {
+		n.DivMod(n, base, digit)
+		res[i] = alphabet[digit.Int64()]
+	}
+
+	return string(res)
+}
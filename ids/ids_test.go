@@ -0,0 +1,287 @@
+package ids_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+	"github.com/breml/proquint/ids"
+)
+
+type conversion struct {
+	from func(string, ...proquint.EncodingOption) (string, error)
+	to   func(string, ...proquint.DecodingOption) (string, error)
+}
+
+var (
+	ulid     = conversion{from: ids.FromULID, to: ids.ToULID}
+	ksuid    = conversion{from: ids.FromKSUID, to: ids.ToKSUID}
+	xid      = conversion{from: ids.FromXID, to: ids.ToXID}
+	objectID = conversion{from: ids.FromObjectID, to: ids.ToObjectID}
+	traceID  = conversion{from: ids.FromTraceID, to: ids.ToTraceID}
+	spanID   = conversion{from: ids.FromSpanID, to: ids.ToSpanID}
+)
+
+func TestRoundTrip(t *testing.T) {
+	tests := []struct {
+		name       string
+		conversion conversion
+		in         string
+
+		want string
+	}{
+		{
+			name:       "ULID",
+			conversion: ulid,
+			in:         "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+
+			want: "bajik-gumup-rilig-tinuk-hudod-vuvun-nasaf-rujir",
+		},
+		{
+			name:       "KSUID",
+			conversion: ksuid,
+			in:         "0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+
+			want: "binon-zizoz-rikod-suhuh-rilun-nuhid-jigur-kodig-gidis-nisuj",
+		},
+		{
+			name:       "xid",
+			conversion: xid,
+			in:         "9m4e2mr0ui3e8a215n4g",
+
+			want: "hukam-vajir-kaguh-miroh-fodad-fulan",
+		},
+		{
+			name:       "ObjectID",
+			conversion: objectID,
+			in:         "507f1f77bcf86cd799439011",
+
+			want: "jaduz-dutul-rugum-kugil-nojag-nabid",
+		},
+		{
+			name:       "trace ID",
+			conversion: traceID,
+			in:         "4bf92f3577b34da6a3ce929d0e0e4736",
+
+			want: "hozun-fusuj-livug-hukok-pazav-napit-bumav-hisuk",
+		},
+		{
+			name:       "span ID",
+			conversion: spanID,
+			in:         "00f067aa0ba902b7",
+
+			want: "bagub-kivop-bovon-bapul",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			quint, err := tc.conversion.from(tc.in, proquint.WithHyphens())
+			require.NoError(t, err)
+			require.Equal(t, tc.want, quint)
+
+			got, err := tc.conversion.to(quint)
+			require.NoError(t, err)
+			require.Equal(t, tc.in, got)
+		})
+	}
+}
+
+func TestNonCanonicalInput(t *testing.T) {
+	tests := []struct {
+		name       string
+		conversion conversion
+		in         string
+
+		want string
+	}{
+		{
+			name:       "ULID - lower case and aliases",
+			conversion: ulid,
+			in:         "01arz3ndektsv4rrffq69g5fav",
+
+			want: "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+		{
+			name:       "ULID - aliases",
+			conversion: ulid,
+			in:         "OIARZ3NDEKTSV4RRFFQ69G5FAV",
+
+			want: "01ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+		{
+			name:       "ObjectID - upper case",
+			conversion: objectID,
+			in:         "507F1F77BCF86CD799439011",
+
+			want: "507f1f77bcf86cd799439011",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			quint, err := tc.conversion.from(tc.in)
+			require.NoError(t, err)
+
+			got, err := tc.conversion.to(quint)
+			require.NoError(t, err)
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestErrors(t *testing.T) {
+	tests := []struct {
+		name       string
+		conversion conversion
+		in         string
+		quint      string
+	}{
+		{
+			name:       "ULID",
+			conversion: ulid,
+			in:         "01ARZ3NDEKTSV4RRFFQ69G5FA",
+			quint:      "bajik-gumup-rilig-tinuk-hudod-vuvun-nasaf",
+		},
+		{
+			name:       "ULID - overflow",
+			conversion: ulid,
+			in:         "81ARZ3NDEKTSV4RRFFQ69G5FAV",
+		},
+		{
+			name:       "ULID - invalid character",
+			conversion: ulid,
+			in:         "01ARZ3NDEKTSV4RRFFQ69G5FAU",
+		},
+		{
+			name:       "KSUID",
+			conversion: ksuid,
+			in:         "0ujtsYcgvSTl8PAuAdqWYSMnLO",
+			quint:      "binon-zizoz-rikod-suhuh-rilun-nuhid-jigur-kodig-gidis",
+		},
+		{
+			name:       "KSUID - overflow",
+			conversion: ksuid,
+			in:         "zzzzzzzzzzzzzzzzzzzzzzzzzzz",
+		},
+		{
+			name:       "xid",
+			conversion: xid,
+			in:         "9m4e2mr0ui3e8a215n4",
+			quint:      "hukam-vajir-kaguh-miroh-fodad",
+		},
+		{
+			name:       "xid - non-canonical final character",
+			conversion: xid,
+			in:         "9m4e2mr0ui3e8a215n4h",
+		},
+		{
+			name:       "ObjectID",
+			conversion: objectID,
+			in:         "507f1f77bcf86cd79943901",
+			quint:      "jaduz-dutul-rugum-kugil-nojag",
+		},
+		{
+			name:       "trace ID",
+			conversion: traceID,
+			in:         "00000000000000000000000000000000",
+			quint:      "babab-babab-babab-babab-babab-babab-babab-babab",
+		},
+		{
+			name:       "span ID",
+			conversion: spanID,
+			in:         "00f067aa0ba902bx",
+			quint:      "babab-babab-babab-babab",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := tc.conversion.from(tc.in)
+			require.Error(t, err)
+
+			if tc.quint == "" {
+				return
+			}
+
+			_, err = tc.conversion.to(tc.quint)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestSortOrder(t *testing.T) {
+	tests := []struct {
+		name       string
+		conversion conversion
+		in         []string
+	}{
+		{
+			name:       "ULID",
+			conversion: ulid,
+			in: []string{
+				"01ARZ3NDEKTSV4RRFFQ69G5FAV",
+				"01ARZ3NDEKTSV4RRFFQ69G5FAW",
+				"01BX5ZZKBKACTAV9WEVGEMMVRZ",
+				"7ZZZZZZZZZZZZZZZZZZZZZZZZZ",
+			},
+		},
+		{
+			name:       "KSUID",
+			conversion: ksuid,
+			in: []string{
+				"0ujtsYcgvSTl8PAuAdqWYSMnLOv",
+				"0ujzPyRiIAffKhBux4PvQdDqMHY",
+				"aWgEPTl1tmebfsQzFP4bxwgy80V",
+			},
+		},
+		{
+			name:       "xid",
+			conversion: xid,
+			in: []string{
+				"9m4e2mr0ui3e8a215n4g",
+				"9m4e2mr0ui3e8a215n50",
+				"cp6q2dp0ui3e8a215n4g",
+			},
+		},
+		{
+			name:       "ObjectID",
+			conversion: objectID,
+			in: []string{
+				"507f191e810c19729de860ea",
+				"507f1f77bcf86cd799439011",
+				"65a1f2b3c4d5e6f708192a3b",
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			require.True(t, slices.IsSorted(tc.in))
+
+			quints := make([]string, 0, len(tc.in))
+			for _, in := range tc.in {
+				quint, err := tc.conversion.from(in, proquint.WithHyphens())
+				require.NoError(t, err)
+
+				quints = append(quints, quint)
+			}
+
+			require.True(t, slices.IsSorted(quints), "proquints not sorted: %v", quints)
+		})
+	}
+}
+
+func ExampleFromULID() {
+	quint, _ := ids.FromULID("01ARZ3NDEKTSV4RRFFQ69G5FAV", proquint.WithHyphens())
+	fmt.Println(quint)
+
+	ulid, _ := ids.ToULID(quint)
+	fmt.Println(ulid)
+	// Output:
+	// bajik-gumup-rilig-tinuk-hudod-vuvun-nasaf-rujir
+	// 01ARZ3NDEKTSV4RRFFQ69G5FAV
+}
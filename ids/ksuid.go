@@ -0,0 +1,39 @@
+package ids
+
+import (
+	"fmt"
+
+	"github.com/breml/proquint"
+)
+
+const (
+	ksuidSize   = 20
+	ksuidLength = 27
+
+	base62Alphabet = "0123456789ABCDEFGHIJKLMNOPQRSTUVWXYZabcdefghijklmnopqrstuvwxyz"
+)
+
+// FromKSUID encodes proquint from the canonical 27 character base62 text
+// form of a KSUID.
+func FromKSUID(in string, opts ...proquint.EncodingOption) (string, error) {
+	res, err := decodeKSUID(in)
+	return fromBytes("KSUID", res, err, opts)
+}
+
+// ToKSUID decodes a proquint to the canonical text form of a KSUID.
+func ToKSUID(in string, opts ...proquint.DecodingOption) (string, error) {
+	res, err := toBytes("KSUID", in, ksuidSize, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBase(res, base62Alphabet, ksuidLength), nil
+}
+
+func decodeKSUID(in string) ([]byte, error) {
+	if len(in) != ksuidLength {
+		return nil, fmt.Errorf("length %d, expect %d characters", len(in), ksuidLength)
+	}
+
+	return decodeBase(in, base62Alphabet, ksuidSize)
+}
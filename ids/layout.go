@@ -0,0 +1,271 @@
+package ids
+
+import (
+	"encoding/binary"
+	"fmt"
+	"reflect"
+	"strconv"
+	"strings"
+
+	"github.com/breml/proquint"
+)
+
+// Field is a named bit field of a Layout.
+type Field struct {
+	Name string
+	Bits int
+}
+
+// Layout packs multiple unsigned integer fields into a single proquint.
+// The first field occupies the most significant bits. The proquint has the
+// minimal number of syllables to hold the sum of all field bits, which must
+// not exceed 64.
+type Layout struct {
+	fields []Field
+	bits   int
+}
+
+// NewLayout returns a new Layout with the given fields.
+func NewLayout(fields ...Field) (*Layout, error) {
+	l := &Layout{
+		fields: fields,
+	}
+
+	names := make(map[string]bool, len(fields))
+	for _, f := range fields {
+		if f.Name == "" {
+			return nil, fmt.Errorf("field name must not be empty")
+		}
+
+		if names[f.Name] {
+			return nil, fmt.Errorf("duplicate field %q", f.Name)
+		}
+
+		names[f.Name] = true
+
+		if f.Bits < 1 {
+			return nil, fmt.Errorf("invalid number of bits %d for field %q", f.Bits, f.Name)
+		}
+
+		l.bits += f.Bits
+	}
+
+	if l.bits == 0 || l.bits > 64 {
+		return nil, fmt.Errorf("invalid layout with %d bits, expect 1 to 64 bits", l.bits)
+	}
+
+	return l, nil
+}
+
+// LayoutOf returns the Layout defined by the struct tags of v, which must
+// be a struct or a pointer to a struct. The fields are taken in the order
+// of the struct fields, the name of the field is the name of the struct
+// field. Only fields with an unsigned integer type and a struct tag are
+// part of the layout:
+//
+//	type ObjectID struct {
+//		Tenant uint16 `proquint:"bits=10"`
+//		Shard  uint8  `proquint:"bits=6"`
+//		Object uint32 `proquint:"bits=32"`
+//	}
+func LayoutOf(v any) (*Layout, error) {
+	typ := reflect.TypeOf(v)
+	if typ != nil && typ.Kind() == reflect.Pointer {
+		typ = typ.Elem()
+	}
+
+	if typ == nil || typ.Kind() != reflect.Struct {
+		return nil, fmt.Errorf("expect struct or pointer to struct, got %T", v)
+	}
+
+	var fields []Field
+
+	for i := range typ.NumField() {
+		sf := typ.Field(i)
+
+		tag, ok := sf.Tag.Lookup("proquint")
+		if !ok || tag == "-" {
+			continue
+		}
+
+		if !isUnsigned(sf.Type.Kind()) {
+			return nil, fmt.Errorf("field %q has unsupported type %s, expect unsigned integer", sf.Name, sf.Type)
+		}
+
+		bits, err := parseBitsTag(tag)
+		if err != nil {
+			return nil, fmt.Errorf("field %q: %w", sf.Name, err)
+		}
+
+		if bits > sf.Type.Bits() {
+			return nil, fmt.Errorf("field %q with %d bits exceeds type %s", sf.Name, bits, sf.Type)
+		}
+
+		fields = append(fields, Field{Name: sf.Name, Bits: bits})
+	}
+
+	return NewLayout(fields...)
+}
+
+func parseBitsTag(tag string) (int, error) {
+	bits := 0
+
+	for _, option := range strings.Split(tag, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(option), "=")
+		if key != "bits" {
+			return 0, fmt.Errorf("unknown tag option %q", key)
+		}
+
+		var err error
+		bits, err = strconv.Atoi(value)
+		if err != nil {
+			return 0, fmt.Errorf("invalid bits %q", value)
+		}
+	}
+
+	if bits == 0 {
+		return 0, fmt.Errorf("tag option bits missing")
+	}
+
+	return bits, nil
+}
+
+// Fields returns the fields of the layout.
+func (l *Layout) Fields() []Field {
+	return append([]Field(nil), l.fields...)
+}
+
+// Pack packs the fields of v into a proquint. v is either a map[string]uint64
+// or a struct or pointer to a struct with a field of unsigned integer type
+// for each field of the layout. Missing map entries are packed as 0. Values,
+// which do not fit into the bits of their field, result in an error.
+func (l *Layout) Pack(v any, opts ...proquint.EncodingOption) (string, error) {
+	var packed uint64
+
+	for _, f := range l.fields {
+		value, err := l.value(v, f.Name)
+		if err != nil {
+			return "", err
+		}
+
+		if f.Bits < 64 && value >= 1<<f.Bits {
+			return "", fmt.Errorf("value %d of field %q overflows %d bits", value, f.Name, f.Bits)
+		}
+
+		packed = packed<<f.Bits | value
+	}
+
+	var buf [8]byte
+	binary.BigEndian.PutUint64(buf[:], packed)
+
+	return proquint.FromBytes(buf[8-l.size():], opts...)
+}
+
+func (l *Layout) value(v any, name string) (uint64, error) {
+	if m, ok := v.(map[string]uint64); ok {
+		return m[name], nil
+	}
+
+	field, err := structField(v, name)
+	if err != nil {
+		return 0, err
+	}
+
+	return field.Uint(), nil
+}
+
+// Unpack unpacks the fields of the proquint into v, which is either a
+// non-nil map[string]uint64 or a pointer to a struct with a field of
+// unsigned integer type for each field of the layout.
+func (l *Layout) Unpack(in string, v any, opts ...proquint.DecodingOption) error {
+	res, err := proquint.ToBytes(in, opts...)
+	if err != nil {
+		return err
+	}
+
+	if len(res) != l.size() {
+		return fmt.Errorf("invalid input with %d syllables, expect %d", len(res)/2, l.size()/2)
+	}
+
+	var buf [8]byte
+	copy(buf[8-len(res):], res)
+	packed := binary.BigEndian.Uint64(buf[:])
+
+	if l.bits < 64 && packed >= 1<<l.bits {
+		return fmt.Errorf("invalid input, value exceeds %d bits of layout", l.bits)
+	}
+
+	m, isMap := v.(map[string]uint64)
+	if isMap && m == nil {
+		return fmt.Errorf("map must not be nil")
+	}
+
+	if !isMap && reflect.ValueOf(v).Kind() != reflect.Pointer {
+		return fmt.Errorf("expect map[string]uint64 or pointer to struct, got %T", v)
+	}
+
+	shift := l.bits
+	for _, f := range l.fields {
+		shift -= f.Bits
+		value := packed >> shift & (1<<f.Bits - 1)
+
+		if isMap {
+			m[f.Name] = value
+			continue
+		}
+
+		field, err := structField(v, f.Name)
+		if err != nil {
+			return err
+		}
+
+		if !field.CanSet() {
+			return fmt.Errorf("field %q can not be set", f.Name)
+		}
+
+		if field.OverflowUint(value) {
+			return fmt.Errorf("value %d of field %q overflows %s", value, f.Name, field.Type())
+		}
+
+		field.SetUint(value)
+	}
+
+	return nil
+}
+
+// size returns the size of the packed value in bytes, rounded up to full
+// syllables.
+func (l *Layout) size() int {
+	return (l.bits + 15) / 16 * 2
+}
+
+func structField(v any, name string) (reflect.Value, error) {
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer {
+		rv = rv.Elem()
+	}
+
+	if rv.Kind() != reflect.Struct {
+		return reflect.Value{}, fmt.Errorf("expect map[string]uint64 or struct, got %T", v)
+	}
+
+	field := rv.FieldByName(name)
+	if !field.IsValid() {
+		return reflect.Value{}, fmt.Errorf("struct %s has no field %q", rv.Type(), name)
+	}
+
+	if !isUnsigned(field.Kind()) {
+		return reflect.Value{}, fmt.Errorf("field %q has unsupported type %s, expect unsigned integer", name, field.Type())
+	}
+
+	return field, nil
+}
+
+func isUnsigned(kind reflect.Kind) bool {
+	switch kind {
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return true
+	default:
+		return false
+	}
+}
@@ -0,0 +1,197 @@
+package ids_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+	"github.com/breml/proquint/ids"
+)
+
+type compositeID struct {
+	Tenant uint16 `proquint:"bits=10"`
+	Shard  uint8  `proquint:"bits=6"`
+	Object uint32 `proquint:"bits=32"`
+	Note   string
+}
+
+func TestLayoutOf(t *testing.T) {
+	layout, err := ids.LayoutOf(&compositeID{})
+	require.NoError(t, err)
+	require.Equal(t, []ids.Field{
+		{Name: "Tenant", Bits: 10},
+		{Name: "Shard", Bits: 6},
+		{Name: "Object", Bits: 32},
+	}, layout.Fields())
+
+	tests := []struct {
+		name string
+		in   any
+	}{
+		{
+			name: "not a struct",
+			in:   42,
+		},
+		{
+			name: "nil",
+			in:   nil,
+		},
+		{
+			name: "signed field",
+			in: struct {
+				A int16 `proquint:"bits=10"`
+			}{},
+		},
+		{
+			name: "bits exceed type",
+			in: struct {
+				A uint8 `proquint:"bits=10"`
+			}{},
+		},
+		{
+			name: "invalid bits",
+			in: struct {
+				A uint8 `proquint:"bits=x"`
+			}{},
+		},
+		{
+			name: "missing bits",
+			in: struct {
+				A uint8 `proquint:""`
+			}{},
+		},
+		{
+			name: "unknown option",
+			in: struct {
+				A uint8 `proquint:"bits=4,foo"`
+			}{},
+		},
+		{
+			name: "no fields",
+			in:   struct{}{},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ids.LayoutOf(tc.in)
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestLayoutPackUnpack(t *testing.T) {
+	layout, err := ids.LayoutOf(compositeID{})
+	require.NoError(t, err)
+
+	in := compositeID{Tenant: 0x3FF, Shard: 1, Object: 0x7F000001}
+
+	quint, err := layout.Pack(in, proquint.WithHyphens())
+	require.NoError(t, err)
+	require.Equal(t, proquint.FromUint16(0xFFC1)+"-"+proquint.FromUint32(0x7F000001, proquint.WithHyphens()), quint)
+
+	var got compositeID
+	err = layout.Unpack(quint, &got)
+	require.NoError(t, err)
+	require.Equal(t, in, got)
+
+	m := map[string]uint64{}
+	err = layout.Unpack(quint, m)
+	require.NoError(t, err)
+	require.Equal(t, map[string]uint64{"Tenant": 0x3FF, "Shard": 1, "Object": 0x7F000001}, m)
+
+	quintFromMap, err := layout.Pack(m, proquint.WithHyphens())
+	require.NoError(t, err)
+	require.Equal(t, quint, quintFromMap)
+}
+
+func TestLayoutSyllables(t *testing.T) {
+	layout, err := ids.NewLayout(ids.Field{Name: "a", Bits: 4}, ids.Field{Name: "b", Bits: 13})
+	require.NoError(t, err)
+
+	quint, err := layout.Pack(map[string]uint64{"a": 0xF, "b": 1})
+	require.NoError(t, err)
+	require.Equal(t, proquint.FromUint32(0xF<<13|1), quint)
+
+	m := map[string]uint64{}
+	require.NoError(t, layout.Unpack(quint, m))
+	require.Equal(t, map[string]uint64{"a": 0xF, "b": 1}, m)
+
+	full, err := ids.NewLayout(ids.Field{Name: "a", Bits: 64})
+	require.NoError(t, err)
+
+	quint, err = full.Pack(map[string]uint64{"a": 1<<64 - 1})
+	require.NoError(t, err)
+	require.Equal(t, "zuzuzzuzuzzuzuzzuzuz", quint)
+
+	require.NoError(t, full.Unpack(quint, m))
+	require.Equal(t, uint64(1<<64-1), m["a"])
+}
+
+func TestLayoutErrors(t *testing.T) {
+	_, err := ids.NewLayout(ids.Field{Name: "a", Bits: 40}, ids.Field{Name: "b", Bits: 25})
+	require.Error(t, err, "exceeds 64 bits")
+
+	_, err = ids.NewLayout(ids.Field{Name: "a", Bits: 4}, ids.Field{Name: "a", Bits: 4})
+	require.Error(t, err, "duplicate field")
+
+	_, err = ids.NewLayout(ids.Field{Name: "a", Bits: 0})
+	require.Error(t, err, "zero bits")
+
+	_, err = ids.NewLayout(ids.Field{Bits: 4})
+	require.Error(t, err, "empty name")
+
+	layout, err := ids.LayoutOf(compositeID{})
+	require.NoError(t, err)
+
+	_, err = layout.Pack(compositeID{Tenant: 0x400})
+	require.ErrorContains(t, err, `field "Tenant" overflows 10 bits`)
+
+	_, err = layout.Pack(map[string]uint64{"Shard": 64})
+	require.ErrorContains(t, err, `field "Shard" overflows 6 bits`)
+
+	_, err = layout.Pack(struct{ Tenant uint16 }{})
+	require.Error(t, err, "missing struct field")
+
+	_, err = layout.Pack("invalid")
+	require.Error(t, err)
+
+	var got compositeID
+	require.Error(t, layout.Unpack("babab-babab", &got), "too few syllables")
+	require.Error(t, layout.Unpack("babab-babab-baXab", &got), "invalid character")
+	require.Error(t, layout.Unpack("babab-babab-babab", got), "not a pointer")
+	require.Error(t, layout.Unpack("babab-babab-babab", map[string]uint64(nil)), "nil map")
+
+	small, err := ids.NewLayout(ids.Field{Name: "a", Bits: 4})
+	require.NoError(t, err)
+	require.Error(t, small.Unpack("zuzuz", map[string]uint64{}), "exceeds bits of layout")
+
+	narrow := struct {
+		A uint8
+	}{}
+	wide, err := ids.NewLayout(ids.Field{Name: "A", Bits: 16})
+	require.NoError(t, err)
+	require.Error(t, wide.Unpack("zuzuz", &narrow), "overflows struct field")
+}
+
+func ExampleLayout() {
+	type objectID struct {
+		Tenant uint16 `proquint:"bits=10"`
+		Shard  uint8  `proquint:"bits=6"`
+		Object uint32 `proquint:"bits=32"`
+	}
+
+	layout, _ := ids.LayoutOf(objectID{})
+
+	quint, _ := layout.Pack(objectID{Tenant: 42, Shard: 3, Object: 1234}, proquint.WithHyphens())
+	fmt.Println(quint)
+
+	var id objectID
+	_ = layout.Unpack(quint, &id)
+	fmt.Printf("%+v\n", id)
+	// Output:
+	// bopag-babab-bigif
+	// {Tenant:42 Shard:3 Object:1234}
+}
@@ -0,0 +1,38 @@
+package ids
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/breml/proquint"
+)
+
+const objectIDSize = 12
+
+// FromObjectID encodes proquint from the canonical 24 character hex text
+// form of a MongoDB ObjectID. The hex characters are decoded case
+// insensitive.
+func FromObjectID(in string, opts ...proquint.EncodingOption) (string, error) {
+	res, err := decodeHex(in, objectIDSize)
+	return fromBytes("ObjectID", res, err, opts)
+}
+
+// ToObjectID decodes a proquint to the canonical text form of a MongoDB
+// ObjectID.
+func ToObjectID(in string, opts ...proquint.DecodingOption) (string, error) {
+	res, err := toBytes("ObjectID", in, objectIDSize, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return hex.EncodeToString(res), nil
+}
+
+// decodeHex decodes a hex string of size bytes.
+func decodeHex(in string, size int) ([]byte, error) {
+	if len(in) != size*2 {
+		return nil, fmt.Errorf("length %d, expect %d characters", len(in), size*2)
+	}
+
+	return hex.DecodeString(in)
+}
@@ -0,0 +1,218 @@
+package ids
+
+import (
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/breml/proquint"
+)
+
+// ErrClockRollback is returned by Snowflake.Next, if the clock moved
+// backwards by more than the tolerated rollback.
+var ErrClockRollback = errors.New("clock moved backwards")
+
+// SnowflakeLayout defines the bit layout of Snowflake IDs. From the most to
+// the least significant bit, an ID consists of the milliseconds since the
+// epoch, the node ID and the sequence number. The total number of bits must
+// not exceed 64.
+type SnowflakeLayout struct {
+	TimestampBits int
+	NodeBits      int
+	SequenceBits  int
+}
+
+// DefaultSnowflakeLayout is the original Twitter Snowflake layout with 41
+// timestamp bits, 10 node bits and 12 sequence bits, leaving the sign bit
+// unused. The 41 timestamp bits last for about 69.7 years, together with
+// DefaultSnowflakeEpoch until 2094-09-07T15:47:35.551Z.
+var DefaultSnowflakeLayout = SnowflakeLayout{
+	TimestampBits: 41,
+	NodeBits:      10,
+	SequenceBits:  12,
+}
+
+// DefaultSnowflakeEpoch is the default epoch of a Snowflake. Like the epoch
+// of Twitter in 2010, it is recent to not waste the range of the timestamp
+// on the past.
+var DefaultSnowflakeEpoch = time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+
+func (l SnowflakeLayout) validate() error {
+	if l.TimestampBits < 1 || l.NodeBits < 0 || l.SequenceBits < 1 {
+		return fmt.Errorf("invalid layout %+v, timestamp and sequence need at least 1 bit", l)
+	}
+
+	if l.TimestampBits+l.NodeBits+l.SequenceBits > 64 {
+		return fmt.Errorf("invalid layout %+v, exceeds 64 bits", l)
+	}
+
+	return nil
+}
+
+// Snowflake generates Snowflake-style IDs, which are unique across all nodes
+// with a distinct node ID without any coordination. Within a node, the IDs
+// are strictly increasing.
+//
+// A Snowflake is safe for concurrent use.
+type Snowflake struct {
+	node        uint64
+	layout      SnowflakeLayout
+	epoch       time.Time
+	maxRollback time.Duration
+	now         func() time.Time
+	sleep       func(time.Duration)
+	opts        []proquint.EncodingOption
+
+	mu         sync.Mutex
+	lastMillis int64
+	sequence   uint64
+}
+
+// SnowflakeOption configures a Snowflake.
+type SnowflakeOption func(*Snowflake)
+
+// WithSnowflakeLayout sets the bit layout. The default is
+// DefaultSnowflakeLayout.
+func WithSnowflakeLayout(layout SnowflakeLayout) SnowflakeOption {
+	return func(s *Snowflake) {
+		s.layout = layout
+	}
+}
+
+// WithSnowflakeEpoch sets the epoch, from which the milliseconds are
+// counted. The default is DefaultSnowflakeEpoch.
+func WithSnowflakeEpoch(epoch time.Time) SnowflakeOption {
+	return func(s *Snowflake) {
+		s.epoch = epoch
+	}
+}
+
+// WithSnowflakeMaxRollback sets the tolerated clock rollback. If the clock
+// moves backwards by up to this duration, the timestamp of the previous ID
+// is kept until the clock catches up. The default is 1 second.
+func WithSnowflakeMaxRollback(maxRollback time.Duration) SnowflakeOption {
+	return func(s *Snowflake) {
+		s.maxRollback = maxRollback
+	}
+}
+
+// WithSnowflakeClock sets the functions to get the current time and to wait
+// for the clock to advance. The defaults are time.Now and time.Sleep.
+func WithSnowflakeClock(now func() time.Time, sleep func(time.Duration)) SnowflakeOption {
+	return func(s *Snowflake) {
+		s.now = now
+		s.sleep = sleep
+	}
+}
+
+// WithSnowflakeEncodingOptions sets the options used to encode the
+// generated IDs.
+func WithSnowflakeEncodingOptions(opts ...proquint.EncodingOption) SnowflakeOption {
+	return func(s *Snowflake) {
+		s.opts = opts
+	}
+}
+
+// NewSnowflake returns a new Snowflake for the given node ID.
+func NewSnowflake(node uint64, opts ...SnowflakeOption) (*Snowflake, error) {
+	s := &Snowflake{
+		node:        node,
+		layout:      DefaultSnowflakeLayout,
+		epoch:       DefaultSnowflakeEpoch,
+		maxRollback: time.Second,
+		now:         time.Now,
+		sleep:       time.Sleep,
+		lastMillis:  -1,
+	}
+
+	for _, opt := range opts {
+		opt(s)
+	}
+
+	err := s.layout.validate()
+	if err != nil {
+		return nil, err
+	}
+
+	if node >= 1<<s.layout.NodeBits {
+		return nil, fmt.Errorf("node ID %d exceeds %d node bits", node, s.layout.NodeBits)
+	}
+
+	return s, nil
+}
+
+// Next returns the next ID and its proquint encoding. If the sequence
+// numbers of the current millisecond are exhausted, Next waits for the next
+// millisecond.
+func (s *Snowflake) Next() (uint64, string, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	millis, err := s.millis()
+	if err != nil {
+		return 0, "", err
+	}
+
+	if millis == s.lastMillis {
+		s.sequence++
+		if s.sequence >= 1<<s.layout.SequenceBits {
+			// Sequence exhausted, wait for the next millisecond.
+			for millis <= s.lastMillis {
+				s.sleep(time.Millisecond)
+
+				millis, err = s.millis()
+				if err != nil {
+					return 0, "", err
+				}
+			}
+
+			s.sequence = 0
+		}
+	} else {
+		s.sequence = 0
+	}
+
+	if s.layout.TimestampBits < 63 && millis >= 1<<s.layout.TimestampBits {
+		return 0, "", fmt.Errorf("timestamp overflows %d timestamp bits", s.layout.TimestampBits)
+	}
+
+	s.lastMillis = millis
+
+	id := uint64(millis)<<(s.layout.NodeBits+s.layout.SequenceBits) |
+		s.node<<s.layout.SequenceBits |
+		s.sequence
+
+	return id, proquint.FromUint64(id, s.opts...), nil
+}
+
+// millis returns the milliseconds since the epoch. A tolerated clock
+// rollback returns the milliseconds of the previous ID.
+func (s *Snowflake) millis() (int64, error) {
+	now := s.now()
+	millis := now.UnixMilli() - s.epoch.UnixMilli()
+	if millis < 0 {
+		return 0, fmt.Errorf("current time %s is before epoch %s", now, s.epoch)
+	}
+
+	if millis < s.lastMillis {
+		rollback := time.Duration(s.lastMillis-millis) * time.Millisecond
+		if rollback > s.maxRollback {
+			return 0, fmt.Errorf("%w by %s", ErrClockRollback, rollback)
+		}
+
+		return s.lastMillis, nil
+	}
+
+	return millis, nil
+}
+
+// Decompose returns the creation time, the node ID and the sequence number
+// of an ID generated with the same layout and epoch.
+func (s *Snowflake) Decompose(id uint64) (time.Time, uint64, uint64) {
+	sequence := id & (1<<s.layout.SequenceBits - 1)
+	node := id >> s.layout.SequenceBits & (1<<s.layout.NodeBits - 1)
+	millis := id >> (s.layout.NodeBits + s.layout.SequenceBits)
+
+	return time.UnixMilli(s.epoch.UnixMilli() + int64(millis)), node, sequence
+}
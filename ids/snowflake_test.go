@@ -0,0 +1,159 @@
+package ids_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+	"github.com/breml/proquint/ids"
+)
+
+func TestSnowflake(t *testing.T) {
+	epoch := time.Date(2025, 1, 1, 0, 0, 0, 0, time.UTC)
+	clock := &fakeClock{now: epoch.Add(time.Second)}
+
+	s, err := ids.NewSnowflake(5,
+		ids.WithSnowflakeEpoch(epoch),
+		ids.WithSnowflakeClock(clock.Now, clock.Advance),
+		ids.WithSnowflakeEncodingOptions(proquint.WithHyphens()),
+	)
+	require.NoError(t, err)
+
+	id, quint, err := s.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000<<22|5<<12), id)
+	require.Equal(t, proquint.FromUint64(id, proquint.WithHyphens()), quint)
+
+	id, _, err = s.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1000<<22|5<<12|1), id)
+
+	clock.Advance(time.Millisecond)
+
+	id, _, err = s.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1001<<22|5<<12), id)
+
+	ts, node, sequence := s.Decompose(id)
+	require.True(t, epoch.Add(1001*time.Millisecond).Equal(ts))
+	require.Equal(t, uint64(5), node)
+	require.Equal(t, uint64(0), sequence)
+}
+
+func TestSnowflakeSequenceExhausted(t *testing.T) {
+	clock := &fakeClock{now: ids.DefaultSnowflakeEpoch.Add(100 * time.Millisecond)}
+
+	var slept time.Duration
+	sleep := func(d time.Duration) {
+		slept += d
+		clock.Advance(d)
+	}
+
+	s, err := ids.NewSnowflake(1,
+		ids.WithSnowflakeLayout(ids.SnowflakeLayout{TimestampBits: 20, NodeBits: 2, SequenceBits: 2}),
+		ids.WithSnowflakeClock(clock.Now, sleep),
+	)
+	require.NoError(t, err)
+
+	var got []uint64
+	for range 5 {
+		id, _, err := s.Next()
+		require.NoError(t, err)
+
+		got = append(got, id)
+	}
+
+	require.Equal(t, []uint64{
+		100<<4 | 1<<2 | 0,
+		100<<4 | 1<<2 | 1,
+		100<<4 | 1<<2 | 2,
+		100<<4 | 1<<2 | 3,
+		101<<4 | 1<<2 | 0,
+	}, got)
+	require.Equal(t, time.Millisecond, slept)
+}
+
+func TestSnowflakeClockRollback(t *testing.T) {
+	clock := &fakeClock{now: ids.DefaultSnowflakeEpoch.Add(100 * time.Millisecond)}
+
+	s, err := ids.NewSnowflake(0,
+		ids.WithSnowflakeLayout(ids.SnowflakeLayout{TimestampBits: 20, NodeBits: 0, SequenceBits: 4}),
+		ids.WithSnowflakeClock(clock.Now, clock.Advance),
+		ids.WithSnowflakeMaxRollback(10*time.Millisecond),
+	)
+	require.NoError(t, err)
+
+	id, _, err := s.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(100<<4), id)
+
+	// Tolerated rollback, keep the previous timestamp.
+	clock.Advance(-5 * time.Millisecond)
+
+	id, _, err = s.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(100<<4|1), id)
+
+	// Rollback exceeds the tolerated rollback.
+	clock.Advance(-10 * time.Millisecond)
+
+	_, _, err = s.Next()
+	require.ErrorIs(t, err, ids.ErrClockRollback)
+
+	// Clock caught up.
+	clock.Advance(16 * time.Millisecond)
+
+	id, _, err = s.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(101<<4), id)
+}
+
+func TestSnowflakeDefaultEpochRange(t *testing.T) {
+	end := time.Date(2094, 9, 7, 15, 47, 35, 552_000_000, time.UTC)
+	clock := &fakeClock{now: end.Add(-time.Millisecond)}
+
+	s, err := ids.NewSnowflake(0, ids.WithSnowflakeClock(clock.Now, clock.Advance))
+	require.NoError(t, err)
+
+	id, _, err := s.Next()
+	require.NoError(t, err)
+	require.Equal(t, uint64(1<<41-1)<<22, id)
+
+	clock.Advance(time.Millisecond)
+
+	_, _, err = s.Next()
+	require.Error(t, err, "timestamp overflow")
+}
+
+func TestSnowflakeErrors(t *testing.T) {
+	_, err := ids.NewSnowflake(1024)
+	require.Error(t, err, "node ID exceeds node bits")
+
+	_, err = ids.NewSnowflake(0, ids.WithSnowflakeLayout(ids.SnowflakeLayout{TimestampBits: 41, NodeBits: 12, SequenceBits: 12}))
+	require.Error(t, err, "layout exceeds 64 bits")
+
+	_, err = ids.NewSnowflake(0, ids.WithSnowflakeLayout(ids.SnowflakeLayout{TimestampBits: 41, NodeBits: 10}))
+	require.Error(t, err, "no sequence bits")
+
+	clock := &fakeClock{now: ids.DefaultSnowflakeEpoch.Add(1 << 10 * time.Millisecond)}
+
+	s, err := ids.NewSnowflake(0,
+		ids.WithSnowflakeLayout(ids.SnowflakeLayout{TimestampBits: 10, NodeBits: 0, SequenceBits: 4}),
+		ids.WithSnowflakeClock(clock.Now, clock.Advance),
+	)
+	require.NoError(t, err)
+
+	_, _, err = s.Next()
+	require.Error(t, err, "timestamp overflow")
+
+	s, err = ids.NewSnowflake(0,
+		ids.WithSnowflakeEpoch(ids.DefaultSnowflakeEpoch.Add(1<<11*time.Millisecond)),
+		ids.WithSnowflakeClock(clock.Now, clock.Advance),
+	)
+	require.NoError(t, err)
+
+	_, _, err = s.Next()
+	require.Error(t, err, "before epoch")
+}
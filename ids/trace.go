@@ -0,0 +1,77 @@
+package ids
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/breml/proquint"
+)
+
+const (
+	traceIDSize = 16
+	spanIDSize  = 8
+)
+
+// FromTraceID encodes proquint from the canonical 32 character lower case
+// hex text form of an OpenTelemetry (W3C Trace Context) trace ID. The
+// all-zero trace ID is invalid.
+func FromTraceID(in string, opts ...proquint.EncodingOption) (string, error) {
+	res, err := decodeOTelID(in, traceIDSize)
+	return fromBytes("trace ID", res, err, opts)
+}
+
+// ToTraceID decodes a proquint to the canonical text form of an
+// OpenTelemetry trace ID.
+func ToTraceID(in string, opts ...proquint.DecodingOption) (string, error) {
+	return toOTelID("trace ID", in, traceIDSize, opts)
+}
+
+// FromSpanID encodes proquint from the canonical 16 character lower case
+// hex text form of an OpenTelemetry (W3C Trace Context) span ID. The
+// all-zero span ID is invalid.
+func FromSpanID(in string, opts ...proquint.EncodingOption) (string, error) {
+	res, err := decodeOTelID(in, spanIDSize)
+	return fromBytes("span ID", res, err, opts)
+}
+
+// ToSpanID decodes a proquint to the canonical text form of an
+// OpenTelemetry span ID.
+func ToSpanID(in string, opts ...proquint.DecodingOption) (string, error) {
+	return toOTelID("span ID", in, spanIDSize, opts)
+}
+
+func decodeOTelID(in string, size int) ([]byte, error) {
+	res, err := decodeHex(in, size)
+	if err != nil {
+		return nil, err
+	}
+
+	if isZero(res) {
+		return nil, fmt.Errorf("all-zero ID is invalid")
+	}
+
+	return res, nil
+}
+
+func toOTelID(format string, in string, size int, opts []proquint.DecodingOption) (string, error) {
+	res, err := toBytes(format, in, size, opts)
+	if err != nil {
+		return "", err
+	}
+
+	if isZero(res) {
+		return "", fmt.Errorf("invalid %s: all-zero ID is invalid", format)
+	}
+
+	return hex.EncodeToString(res), nil
+}
+
+func isZero(in []byte) bool {
+	for _, b := range in {
+		if b != 0 {
+			return false
+		}
+	}
+
+	return true
+}
@@ -0,0 +1,44 @@
+package ids
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/breml/proquint"
+)
+
+const (
+	ulidSize   = 16
+	ulidLength = 26
+
+	crockfordAlphabet = "0123456789ABCDEFGHJKMNPQRSTVWXYZ"
+)
+
+// crockfordAliases maps the characters, which are decoded as aliases
+// according to Crockford's Base32.
+var crockfordAliases = strings.NewReplacer("I", "1", "L", "1", "O", "0")
+
+// FromULID encodes proquint from the canonical 26 character text form of a
+// ULID. The ULID is decoded case insensitive.
+func FromULID(in string, opts ...proquint.EncodingOption) (string, error) {
+	res, err := decodeULID(in)
+	return fromBytes("ULID", res, err, opts)
+}
+
+// ToULID decodes a proquint to the canonical text form of a ULID.
+func ToULID(in string, opts ...proquint.DecodingOption) (string, error) {
+	res, err := toBytes("ULID", in, ulidSize, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return encodeBase(res, crockfordAlphabet, ulidLength), nil
+}
+
+func decodeULID(in string) ([]byte, error) {
+	if len(in) != ulidLength {
+		return nil, fmt.Errorf("length %d, expect %d characters", len(in), ulidLength)
+	}
+
+	return decodeBase(crockfordAliases.Replace(strings.ToUpper(in)), crockfordAlphabet, ulidSize)
+}
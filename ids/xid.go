@@ -0,0 +1,51 @@
+package ids
+
+import (
+	"encoding/base32"
+	"fmt"
+
+	"github.com/breml/proquint"
+)
+
+const (
+	xidSize   = 12
+	xidLength = 20
+)
+
+var xidEncoding = base32.NewEncoding("0123456789abcdefghijklmnopqrstuv").WithPadding(base32.NoPadding)
+
+// FromXID encodes proquint from the canonical 20 character base32hex text
+// form of a xid.
+func FromXID(in string, opts ...proquint.EncodingOption) (string, error) {
+	res, err := decodeXID(in)
+	return fromBytes("xid", res, err, opts)
+}
+
+// ToXID decodes a proquint to the canonical text form of a xid.
+func ToXID(in string, opts ...proquint.DecodingOption) (string, error) {
+	res, err := toBytes("xid", in, xidSize, opts)
+	if err != nil {
+		return "", err
+	}
+
+	return xidEncoding.EncodeToString(res), nil
+}
+
+func decodeXID(in string) ([]byte, error) {
+	if len(in) != xidLength {
+		return nil, fmt.Errorf("length %d, expect %d characters", len(in), xidLength)
+	}
+
+	res, err := xidEncoding.DecodeString(in)
+	if err != nil {
+		return nil, err
+	}
+
+	// The final character only carries a single bit, reject any other bits
+	// to guarantee an exact round trip.
+	if xidEncoding.EncodeToString(res) != in {
+		return nil, fmt.Errorf("non-canonical final character %q", in[xidLength-1:])
+	}
+
+	return res, nil
+}
@@ -0,0 +1,297 @@
+package proquint
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+)
+
+// IndexEntry is an entry of an Index.
+type IndexEntry[V any] struct {
+	// Key is the proquint of the entry in lower case with hyphens.
+	Key string
+
+	// Value is the payload of the entry.
+	Value V
+
+	// Distance is the number of substituted letters for the results of
+	// Index.FuzzySearch, otherwise 0.
+	Distance int
+}
+
+// Index is an in-memory index of proquints mapping to payloads of type V. It
+// is implemented as trie keyed by syllable and supports prefix and fuzzy
+// search. The keys are case-insensitive and may be given with or without
+// hyphens. An Index is safe for concurrent use, lookups do not block each
+// other.
+type Index[V any] struct {
+	mu   sync.RWMutex
+	root *indexNode[V]
+	size int
+}
+
+type indexNode[V any] struct {
+	children map[string]*indexNode[V]
+	hasValue bool
+	value    V
+}
+
+// NewIndex returns an empty Index.
+func NewIndex[V any]() *Index[V] {
+	return &Index[V]{
+		root: &indexNode[V]{},
+	}
+}
+
+// Insert adds the proquint with the given payload to the index. The payload
+// of an existing proquint is replaced.
+func (i *Index[V]) Insert(quint string, value V) error {
+	quints, err := indexSyllables(quint)
+	if err != nil {
+		return err
+	}
+
+	if len(quints) == 0 {
+		return fmt.Errorf("invalid empty proquint")
+	}
+
+	for _, q := range quints {
+		_, err := decodeSyllable(q)
+		if err != nil {
+			return err
+		}
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	node := i.root
+	for _, q := range quints {
+		child, ok := node.children[q]
+		if !ok {
+			if node.children == nil {
+				node.children = map[string]*indexNode[V]{}
+			}
+
+			child = &indexNode[V]{}
+			node.children[q] = child
+		}
+
+		node = child
+	}
+
+	if !node.hasValue {
+		i.size++
+	}
+
+	node.hasValue = true
+	node.value = value
+
+	return nil
+}
+
+// Delete removes the proquint from the index. It returns false, if the
+// proquint is not in the index.
+func (i *Index[V]) Delete(quint string) bool {
+	quints, err := indexSyllables(quint)
+	if err != nil {
+		return false
+	}
+
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	path := []*indexNode[V]{i.root}
+	for _, q := range quints {
+		child, ok := path[len(path)-1].children[q]
+		if !ok {
+			return false
+		}
+
+		path = append(path, child)
+	}
+
+	node := path[len(path)-1]
+	if !node.hasValue {
+		return false
+	}
+
+	var zero V
+	node.hasValue = false
+	node.value = zero
+	i.size--
+
+	// Remove the nodes, which do not lead to any value anymore.
+	for j := len(path) - 1; j > 0; j-- {
+		if path[j].hasValue || len(path[j].children) > 0 {
+			break
+		}
+
+		delete(path[j-1].children, quints[j-1])
+	}
+
+	return true
+}
+
+// Get returns the payload of the proquint.
+func (i *Index[V]) Get(quint string) (V, bool) {
+	var zero V
+
+	quints, err := indexSyllables(quint)
+	if err != nil {
+		return zero, false
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	node := i.root
+	for _, q := range quints {
+		child, ok := node.children[q]
+		if !ok {
+			return zero, false
+		}
+
+		node = child
+	}
+
+	if !node.hasValue {
+		return zero, false
+	}
+
+	return node.value, true
+}
+
+// Len returns the number of proquints in the index.
+func (i *Index[V]) Len() int {
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	return i.size
+}
+
+// PrefixSearch returns all entries, whose proquint starts with the given
+// prefix, sorted by key. The prefix may end within a syllable.
+func (i *Index[V]) PrefixSearch(prefix string) []IndexEntry[V] {
+	letters := normalizeLetters(prefix)
+	partial := letters[len(letters)-len(letters)%5:]
+
+	// The complete syllables of the prefix are always valid input.
+	quints, _ := indexSyllables(letters[:len(letters)-len(partial)])
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	node := i.root
+	for _, q := range quints {
+		child, ok := node.children[q]
+		if !ok {
+			return nil
+		}
+
+		node = child
+	}
+
+	var res []IndexEntry[V]
+	for q, child := range node.children {
+		if strings.HasPrefix(q, partial) {
+			res = child.collect(append(slices.Clone(quints), q), res)
+		}
+	}
+
+	if partial == "" && node.hasValue {
+		res = append(res, IndexEntry[V]{Key: strings.Join(quints, "-"), Value: node.value})
+	}
+
+	slices.SortFunc(res, func(a, b IndexEntry[V]) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+
+	return res
+}
+
+// collect appends the entries of the node and all its descendants to res.
+func (n *indexNode[V]) collect(quints []string, res []IndexEntry[V]) []IndexEntry[V] {
+	if n.hasValue {
+		res = append(res, IndexEntry[V]{Key: strings.Join(quints, "-"), Value: n.value})
+	}
+
+	for q, child := range n.children {
+		res = child.collect(append(quints[:len(quints):len(quints)], q), res)
+	}
+
+	return res
+}
+
+// FuzzySearch returns all entries with the same number of syllables as the
+// given proquint, which differ in at most k letters. Since the entries are
+// valid proquints, a letter in a consonant slot is only ever substituted by
+// a consonant and a letter in a vowel slot only by a vowel. The results are
+// sorted by distance and key.
+func (i *Index[V]) FuzzySearch(quint string, k int) ([]IndexEntry[V], error) {
+	quints, err := indexSyllables(quint)
+	if err != nil {
+		return nil, err
+	}
+
+	if k < 0 {
+		return nil, fmt.Errorf("invalid number of substitutions %d", k)
+	}
+
+	i.mu.RLock()
+	defer i.mu.RUnlock()
+
+	var res []IndexEntry[V]
+	i.root.fuzzy(quints, nil, k, 0, &res)
+
+	slices.SortFunc(res, func(a, b IndexEntry[V]) int {
+		return cmp.Or(cmp.Compare(a.Distance, b.Distance), strings.Compare(a.Key, b.Key))
+	})
+
+	return res, nil
+}
+
+// fuzzy appends the entries of the descendants of the node, which match the
+// remaining quints within k substitutions, to res.
+func (n *indexNode[V]) fuzzy(quints []string, path []string, k int, distance int, res *[]IndexEntry[V]) {
+	if len(quints) == 0 {
+		if n.hasValue {
+			*res = append(*res, IndexEntry[V]{Key: strings.Join(path, "-"), Value: n.value, Distance: distance})
+		}
+
+		return
+	}
+
+	for q, child := range n.children {
+		d := distance
+		for j := range len(q) {
+			if q[j] != quints[0][j] {
+				d++
+			}
+		}
+
+		if d > k {
+			continue
+		}
+
+		child.fuzzy(quints[1:], append(path[:len(path):len(path)], q), k, d, res)
+	}
+}
+
+// indexSyllables splits the proquint into its syllables, ignoring case and
+// hyphens.
+func indexSyllables(quint string) ([]string, error) {
+	letters := normalizeLetters(quint)
+	if len(letters)%5 != 0 {
+		return nil, fmt.Errorf("invalid proquint %q, length not multiple of 5", quint)
+	}
+
+	quints := make([]string, 0, len(letters)/5)
+	for j := 0; j < len(letters); j += 5 {
+		quints = append(quints, letters[j:j+5])
+	}
+
+	return quints, nil
+}
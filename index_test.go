@@ -0,0 +1,264 @@
+package proquint_test
+
+import (
+	"fmt"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func newTestIndex(t *testing.T) *proquint.Index[string] {
+	t.Helper()
+
+	index := proquint.NewIndex[string]()
+	for quint, host := range map[string]string{
+		"lusab-babad": "localhost",
+		"lusab-babaf": "localhost-2",
+		"lusad-babab": "loopback",
+		"SAFOM-BABAD": "gateway",
+		"safom":       "short",
+	} {
+		require.NoError(t, index.Insert(quint, host))
+	}
+
+	return index
+}
+
+func TestIndexInsert(t *testing.T) {
+	index := newTestIndex(t)
+	require.Equal(t, 5, index.Len())
+
+	got, ok := index.Get("lusabbabad")
+	require.True(t, ok)
+	require.Equal(t, "localhost", got)
+
+	// Replace the payload.
+	require.NoError(t, index.Insert("lusab-babad", "router"))
+	require.Equal(t, 5, index.Len())
+
+	got, ok = index.Get("LUSAB-BABAD")
+	require.True(t, ok)
+	require.Equal(t, "router", got)
+
+	_, ok = index.Get("lusab")
+	require.False(t, ok)
+
+	_, ok = index.Get("lusab-ba")
+	require.False(t, ok)
+
+	require.Error(t, index.Insert("", "empty"))
+	require.Error(t, index.Insert("lusab-ba", "incomplete"))
+	require.Error(t, index.Insert("lusab-cabad", "invalid"))
+}
+
+func TestIndexDelete(t *testing.T) {
+	index := newTestIndex(t)
+
+	require.True(t, index.Delete("safom"))
+	require.False(t, index.Delete("safom"))
+	require.Equal(t, 4, index.Len())
+
+	// The deleted proquint is a prefix of another proquint.
+	got, ok := index.Get("safom-babad")
+	require.True(t, ok)
+	require.Equal(t, "gateway", got)
+
+	require.True(t, index.Delete("lusad-babab"))
+	require.Empty(t, index.PrefixSearch("lusad"))
+
+	require.False(t, index.Delete("lusab"))
+	require.False(t, index.Delete("zuzuz-zuzuz"))
+	require.False(t, index.Delete("lusab-ba"))
+	require.Equal(t, 3, index.Len())
+}
+
+func TestIndexPrefixSearch(t *testing.T) {
+	index := newTestIndex(t)
+
+	tests := []struct {
+		name   string
+		prefix string
+
+		want []proquint.IndexEntry[string]
+	}{
+		{
+			name:   "partial syllable",
+			prefix: "lus",
+
+			want: []proquint.IndexEntry[string]{
+				{Key: "lusab-babad", Value: "localhost"},
+				{Key: "lusab-babaf", Value: "localhost-2"},
+				{Key: "lusad-babab", Value: "loopback"},
+			},
+		},
+		{
+			name:   "complete syllable",
+			prefix: "safom",
+
+			want: []proquint.IndexEntry[string]{
+				{Key: "safom", Value: "short"},
+				{Key: "safom-babad", Value: "gateway"},
+			},
+		},
+		{
+			name:   "second syllable",
+			prefix: "LUSAB-BABAF",
+
+			want: []proquint.IndexEntry[string]{
+				{Key: "lusab-babaf", Value: "localhost-2"},
+			},
+		},
+		{
+			name:   "within second syllable",
+			prefix: "lusabbab",
+
+			want: []proquint.IndexEntry[string]{
+				{Key: "lusab-babad", Value: "localhost"},
+				{Key: "lusab-babaf", Value: "localhost-2"},
+			},
+		},
+		{
+			name:   "no match",
+			prefix: "zuzuz-z",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.want, index.PrefixSearch(test.prefix))
+		})
+	}
+
+	require.Len(t, index.PrefixSearch(""), 5)
+}
+
+func TestIndexFuzzySearch(t *testing.T) {
+	index := newTestIndex(t)
+
+	tests := []struct {
+		name  string
+		quint string
+		k     int
+
+		assertErr require.ErrorAssertionFunc
+		want      []proquint.IndexEntry[string]
+	}{
+		{
+			name:  "exact",
+			quint: "lusab-babad",
+			k:     0,
+
+			assertErr: require.NoError,
+			want: []proquint.IndexEntry[string]{
+				{Key: "lusab-babad", Value: "localhost"},
+			},
+		},
+		{
+			name:  "single substitution",
+			quint: "lusab-babad",
+			k:     1,
+
+			assertErr: require.NoError,
+			want: []proquint.IndexEntry[string]{
+				{Key: "lusab-babad", Value: "localhost"},
+				{Key: "lusab-babaf", Value: "localhost-2", Distance: 1},
+			},
+		},
+		{
+			name:  "two substitutions",
+			quint: "lusab-babad",
+			k:     2,
+
+			assertErr: require.NoError,
+			want: []proquint.IndexEntry[string]{
+				{Key: "lusab-babad", Value: "localhost"},
+				{Key: "lusab-babaf", Value: "localhost-2", Distance: 1},
+				{Key: "lusad-babab", Value: "loopback", Distance: 2},
+			},
+		},
+		{
+			name:  "misheard consonant",
+			quint: "savom-babat",
+			k:     2,
+
+			assertErr: require.NoError,
+			want: []proquint.IndexEntry[string]{
+				{Key: "safom-babad", Value: "gateway", Distance: 2},
+			},
+		},
+		{
+			name:  "invalid letter",
+			quint: "sacom",
+			k:     1,
+
+			assertErr: require.NoError,
+			want: []proquint.IndexEntry[string]{
+				{Key: "safom", Value: "short", Distance: 1},
+			},
+		},
+		{
+			name:  "incomplete syllable",
+			quint: "safo",
+			k:     1,
+
+			assertErr: require.Error,
+		},
+		{
+			name:  "invalid k",
+			quint: "safom",
+			k:     -1,
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := index.FuzzySearch(test.quint, test.k)
+			test.assertErr(t, err)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestIndexConcurrentReaders(t *testing.T) {
+	index := newTestIndex(t)
+
+	var wg sync.WaitGroup
+	for i := range 8 {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			for range 100 {
+				_, _ = index.Get("lusab-babad")
+				_ = index.PrefixSearch("lus")
+				_, _ = index.FuzzySearch("lusab-babad", 2)
+			}
+		}()
+
+		if i%2 == 0 {
+			require.NoError(t, index.Insert(proquint.FromUint32(uint32(i), proquint.WithHyphens()), "writer"))
+		}
+	}
+
+	wg.Wait()
+
+	require.Equal(t, 9, index.Len())
+}
+
+func ExampleIndex() {
+	index := proquint.NewIndex[string]()
+	_ = index.Insert("lusab-babad", "localhost")
+	_ = index.Insert("safom-babad", "gateway")
+
+	matches, _ := index.FuzzySearch("savom-babat", 2)
+	for _, match := range matches {
+		fmt.Println(match.Key, match.Value, match.Distance)
+	}
+	// Output: safom-babad gateway 2
+}
@@ -0,0 +1,166 @@
+// Package invite implements short, speakable and unforgeable invite codes.
+//
+// An invite code consists of the expiry time in seconds since the Unix epoch
+// (4 bytes), the payload and a truncated HMAC-SHA256 of both, encoded as
+// proquint. Payloads with an odd number of bytes are encoded using
+// proquint.HalfSyllablePadding.
+package invite
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"math"
+	"time"
+
+	"github.com/breml/proquint"
+)
+
+// label is used for domain separation of the HMAC.
+const label = "proquint-invite-v1"
+
+const expirySize = 4
+
+var (
+	// ErrMalformed is returned by Verify, if the code can not be decoded.
+	ErrMalformed = errors.New("malformed invite code")
+
+	// ErrBadSignature is returned by Verify, if the signature of the code is
+	// not valid for the key.
+	ErrBadSignature = errors.New("bad invite code signature")
+
+	// ErrExpired is returned by Verify, if the code is expired.
+	ErrExpired = errors.New("invite code expired")
+)
+
+// Invite is a verified invite code.
+type Invite struct {
+	Payload []byte
+	Expiry  time.Time
+}
+
+type config struct {
+	now        func() time.Time
+	tagSize    int
+	opts       []proquint.EncodingOption
+	decodeOpts []proquint.DecodingOption
+}
+
+type Option func(*config)
+
+// WithClock sets the function returning the current time used by Issue.
+// The default is time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(cfg *config) {
+		cfg.now = now
+	}
+}
+
+// WithTagSize sets the size of the truncated HMAC in bytes. Issue and Verify
+// must use the same size. The default is 8 bytes (4 syllables).
+func WithTagSize(size int) Option {
+	return func(cfg *config) {
+		cfg.tagSize = size
+	}
+}
+
+// WithEncodingOptions sets the options used by Issue to encode the code.
+// Options changing the encoded bytes, e.g. proquint.WithTypeTag, require the
+// matching options to be passed to Verify with WithDecodingOptions. The
+// padding is always proquint.HalfSyllablePadding.
+func WithEncodingOptions(opts ...proquint.EncodingOption) Option {
+	return func(cfg *config) {
+		cfg.opts = opts
+	}
+}
+
+// WithDecodingOptions sets the options used by Verify to decode the code.
+// They need to match the options passed to WithEncodingOptions for Issue.
+func WithDecodingOptions(opts ...proquint.DecodingOption) Option {
+	return func(cfg *config) {
+		cfg.decodeOpts = opts
+	}
+}
+
+func newConfig(opts []Option) (config, error) {
+	cfg := config{
+		now:     time.Now,
+		tagSize: 8,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.tagSize < 4 || cfg.tagSize > sha256.Size {
+		return config{}, fmt.Errorf("invalid tag size %d, expect 4 to %d bytes", cfg.tagSize, sha256.Size)
+	}
+
+	return cfg, nil
+}
+
+// Issue returns a new invite code for the payload, which expires after ttl.
+// The expiry time is truncated to full seconds.
+func Issue(key, payload []byte, ttl time.Duration, opts ...Option) (string, error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return "", err
+	}
+
+	expiry := cfg.now().Add(ttl).Unix()
+	if expiry < 0 || expiry > math.MaxUint32 {
+		return "", fmt.Errorf("expiry %d out of range", expiry)
+	}
+
+	data := binary.BigEndian.AppendUint32(nil, uint32(expiry))
+	data = append(data, payload...)
+
+	code := append(data, sign(key, data, cfg.tagSize)...)
+
+	return proquint.FromBytes(code, append(cfg.opts[:len(cfg.opts):len(cfg.opts)], proquint.WithEncodingPadding(proquint.HalfSyllablePadding))...)
+}
+
+// Verify verifies the invite code and returns the invite. The returned
+// error wraps ErrMalformed, ErrBadSignature or ErrExpired. The expiry is
+// only checked for codes with a valid signature.
+func Verify(key []byte, code string, now time.Time, opts ...Option) (Invite, error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return Invite{}, err
+	}
+
+	raw, err := proquint.ToBytes(code, append(cfg.decodeOpts[:len(cfg.decodeOpts):len(cfg.decodeOpts)], proquint.WithDecodingPadding(proquint.HalfSyllablePadding))...)
+	if err != nil {
+		return Invite{}, fmt.Errorf("%w: %w", ErrMalformed, err)
+	}
+
+	if len(raw) < expirySize+cfg.tagSize {
+		return Invite{}, fmt.Errorf("%w: %d bytes are too short", ErrMalformed, len(raw))
+	}
+
+	data, tag := raw[:len(raw)-cfg.tagSize], raw[len(raw)-cfg.tagSize:]
+	if !hmac.Equal(tag, sign(key, data, cfg.tagSize)) {
+		return Invite{}, ErrBadSignature
+	}
+
+	invite := Invite{
+		Payload: data[expirySize:],
+		Expiry:  time.Unix(int64(binary.BigEndian.Uint32(data)), 0),
+	}
+
+	if now.After(invite.Expiry) {
+		return Invite{}, fmt.Errorf("%w at %s", ErrExpired, invite.Expiry.UTC().Format(time.RFC3339))
+	}
+
+	return invite, nil
+}
+
+func sign(key, data []byte, tagSize int) []byte {
+	mac := hmac.New(sha256.New, key)
+	_, _ = mac.Write([]byte(label))
+	_, _ = mac.Write(data)
+
+	return mac.Sum(nil)[:tagSize]
+}
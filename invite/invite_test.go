@@ -0,0 +1,195 @@
+package invite_test
+
+import (
+	"encoding/binary"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+	"github.com/breml/proquint/invite"
+)
+
+var (
+	testKey = []byte("0123456789abcdef0123456789abcdef")
+	testNow = time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+)
+
+func clock() time.Time {
+	return testNow
+}
+
+func TestIssueVerify(t *testing.T) {
+	tests := []struct {
+		name    string
+		payload []byte
+		opts    []invite.Option
+	}{
+		{
+			name:    "even payload",
+			payload: []byte{0x12, 0x34},
+		},
+		{
+			name:    "odd payload",
+			payload: []byte{0x12, 0x34, 0x56},
+		},
+		{
+			name:    "empty payload",
+			payload: []byte{},
+		},
+		{
+			name:    "short tag",
+			payload: []byte{0x12, 0x34},
+			opts:    []invite.Option{invite.WithTagSize(4)},
+		},
+		{
+			name:    "type tag and word byte order",
+			payload: []byte{0x12, 0x34, 0x56},
+			opts: []invite.Option{
+				invite.WithEncodingOptions(proquint.WithTypeTag(7), proquint.WithEncodingWordByteOrder(binary.LittleEndian)),
+				invite.WithDecodingOptions(proquint.WithDecodingTypeTag(7), proquint.WithDecodingWordByteOrder(binary.LittleEndian)),
+			},
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := append([]invite.Option{invite.WithClock(clock), invite.WithEncodingOptions(proquint.WithHyphens())}, tc.opts...)
+
+			code, err := invite.Issue(testKey, tc.payload, time.Hour, opts...)
+			require.NoError(t, err)
+
+			got, err := invite.Verify(testKey, code, testNow.Add(time.Hour), opts...)
+			require.NoError(t, err)
+			require.Equal(t, invite.Invite{Payload: tc.payload, Expiry: time.Unix(testNow.Add(time.Hour).Unix(), 0)}, got)
+		})
+	}
+}
+
+func TestVerifyErrors(t *testing.T) {
+	code, err := invite.Issue(testKey, []byte{0x12, 0x34}, time.Hour, invite.WithClock(clock), invite.WithEncodingOptions(proquint.WithHyphens()))
+	require.NoError(t, err)
+
+	// Flip the last letter of the first syllable to another consonant.
+	tampered := []byte(code)
+	tampered[4] = map[bool]byte{true: 'b', false: 'd'}[tampered[4] != 'b']
+
+	tests := []struct {
+		name string
+		key  []byte
+		code string
+		now  time.Time
+		opts []invite.Option
+
+		wantErr error
+	}{
+		{
+			name: "expired",
+			key:  testKey,
+			code: code,
+			now:  testNow.Add(time.Hour + time.Second),
+
+			wantErr: invite.ErrExpired,
+		},
+		{
+			name: "wrong key",
+			key:  []byte("other key"),
+			code: code,
+			now:  testNow,
+
+			wantErr: invite.ErrBadSignature,
+		},
+		{
+			name: "tampered",
+			key:  testKey,
+			code: string(tampered),
+			now:  testNow,
+
+			wantErr: invite.ErrBadSignature,
+		},
+		{
+			name: "expired and wrong key",
+			key:  []byte("other key"),
+			code: code,
+			now:  testNow.Add(2 * time.Hour),
+
+			wantErr: invite.ErrBadSignature,
+		},
+		{
+			name: "different tag size",
+			key:  testKey,
+			code: code,
+			now:  testNow,
+			opts: []invite.Option{invite.WithTagSize(6)},
+
+			wantErr: invite.ErrBadSignature,
+		},
+		{
+			name: "unexpected type tag",
+			key:  testKey,
+			code: code,
+			now:  testNow,
+			opts: []invite.Option{invite.WithDecodingOptions(proquint.WithDecodingTypeTag(7))},
+
+			wantErr: invite.ErrMalformed,
+		},
+		{
+			name: "invalid character",
+			key:  testKey,
+			code: strings.Replace(code, "-", "-X", 1),
+			now:  testNow,
+
+			wantErr: invite.ErrMalformed,
+		},
+		{
+			name: "too short",
+			key:  testKey,
+			code: code[:17],
+			now:  testNow,
+
+			wantErr: invite.ErrMalformed,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := invite.Verify(tc.key, tc.code, tc.now, tc.opts...)
+			require.ErrorIs(t, err, tc.wantErr)
+		})
+	}
+}
+
+func TestOptionErrors(t *testing.T) {
+	_, err := invite.Issue(testKey, nil, time.Hour, invite.WithTagSize(3))
+	require.Error(t, err)
+
+	_, err = invite.Verify(testKey, "babab", testNow, invite.WithTagSize(33))
+	require.Error(t, err)
+
+	_, err = invite.Issue(testKey, nil, -time.Duration(testNow.Unix()+1)*time.Second, invite.WithClock(clock))
+	require.Error(t, err, "expiry before Unix epoch")
+}
+
+func ExampleIssue() {
+	key := []byte("0123456789abcdef0123456789abcdef")
+	now := time.Date(2025, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	code, _ := invite.Issue(key, []byte{0x00, 0x2A}, 24*time.Hour,
+		invite.WithClock(func() time.Time { return now }),
+		invite.WithEncodingOptions(proquint.WithHyphens()),
+	)
+	fmt.Println(code)
+
+	inv, err := invite.Verify(key, code, now.Add(time.Hour))
+	fmt.Println(inv.Payload, inv.Expiry.UTC(), err)
+
+	_, err = invite.Verify(key, code, now.Add(48*time.Hour))
+	fmt.Println(err)
+	// Output:
+	// kobut-nanab-babop-nulun-jitot-sutus-fuhoj
+	// [0 42] 2025-06-02 12:00:00 +0000 UTC <nil>
+	// invite code expired at 2025-06-02T12:00:00Z
+}
@@ -0,0 +1,236 @@
+// Package otp implements HMAC-based (HOTP, RFC 4226) and time-based (TOTP,
+// RFC 6238) one-time passwords rendered as proquints instead of decimal
+// digits.
+//
+// The HMAC is truncated with the dynamic truncation of RFC 4226. Instead of
+// masking the most significant bit and reducing the 31 bit value modulo a
+// power of 10, the lower 16 bits of the four bytes at the dynamic offset are
+// encoded as a single syllable or all 32 bits are encoded as two syllables.
+// The lower 31 bits of a 32 bit code are the value of RFC 4226.
+package otp
+
+import (
+	"crypto"
+	"crypto/hmac"
+	"crypto/subtle"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	// Register SHA-1 as default hash function.
+	_ "crypto/sha1"
+
+	"github.com/breml/proquint"
+)
+
+// ErrInvalidCode is returned by VerifyHOTP and VerifyTOTP, if the code does
+// not match.
+var ErrInvalidCode = errors.New("invalid one-time code")
+
+type config struct {
+	hash   crypto.Hash
+	bits   int
+	period time.Duration
+	t0     time.Time
+	skew   int
+	now    func() time.Time
+	opts   []proquint.EncodingOption
+}
+
+type Option func(*config)
+
+// WithHash sets the hash function used for the HMAC. The hash function must
+// be linked into the binary. The default is SHA-1.
+func WithHash(h crypto.Hash) Option {
+	return func(cfg *config) {
+		cfg.hash = h
+	}
+}
+
+// WithBits sets the size of the code in bits, 16 (one syllable) or 32 (two
+// syllables). The default is 32. The 32 bit code includes the most
+// significant bit, which RFC 4226 masks.
+func WithBits(bits int) Option {
+	return func(cfg *config) {
+		cfg.bits = bits
+	}
+}
+
+// WithPeriod sets the time step of TOTP, which must be a multiple of a
+// second. The default is 30 seconds.
+func WithPeriod(period time.Duration) Option {
+	return func(cfg *config) {
+		cfg.period = period
+	}
+}
+
+// WithT0 sets the time to start counting the time steps of TOTP. The default
+// is the Unix epoch.
+func WithT0(t0 time.Time) Option {
+	return func(cfg *config) {
+		cfg.t0 = t0
+	}
+}
+
+// WithSkew sets the number of time steps before and after the current time
+// step, which are accepted by VerifyTOTP. The default is 1.
+func WithSkew(steps int) Option {
+	return func(cfg *config) {
+		cfg.skew = steps
+	}
+}
+
+// WithClock sets the function returning the current time used by TOTP and
+// VerifyTOTP. The default is time.Now.
+func WithClock(now func() time.Time) Option {
+	return func(cfg *config) {
+		cfg.now = now
+	}
+}
+
+// WithEncodingOptions sets the options used to encode the code.
+func WithEncodingOptions(opts ...proquint.EncodingOption) Option {
+	return func(cfg *config) {
+		cfg.opts = opts
+	}
+}
+
+func newConfig(opts []Option) (config, error) {
+	cfg := config{
+		hash:   crypto.SHA1,
+		bits:   32,
+		period: 30 * time.Second,
+		t0:     time.Unix(0, 0),
+		skew:   1,
+		now:    time.Now,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if !cfg.hash.Available() {
+		return config{}, fmt.Errorf("hash function %s is not available", cfg.hash)
+	}
+
+	if cfg.bits != 16 && cfg.bits != 32 {
+		return config{}, fmt.Errorf("invalid code size of %d bits, expect 16 or 32", cfg.bits)
+	}
+
+	if cfg.period < time.Second || cfg.period%time.Second != 0 {
+		return config{}, fmt.Errorf("invalid period %s, expect a multiple of 1s", cfg.period)
+	}
+
+	if cfg.skew < 0 {
+		return config{}, fmt.Errorf("invalid skew of %d steps", cfg.skew)
+	}
+
+	return cfg, nil
+}
+
+// HOTP returns the one-time code for the key and the counter.
+func HOTP(key []byte, counter uint64, opts ...Option) (string, error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.code(key, counter), nil
+}
+
+// VerifyHOTP verifies the one-time code for the key and the counter. The
+// code is compared case-insensitive and without hyphens.
+func VerifyHOTP(key []byte, counter uint64, code string, opts ...Option) error {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return err
+	}
+
+	if !equal(cfg.code(key, counter), code) {
+		return ErrInvalidCode
+	}
+
+	return nil
+}
+
+// TOTP returns the one-time code for the key and the current time step.
+func TOTP(key []byte, opts ...Option) (string, error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return "", err
+	}
+
+	counter, err := cfg.counter(cfg.now())
+	if err != nil {
+		return "", err
+	}
+
+	return cfg.code(key, counter), nil
+}
+
+// VerifyTOTP verifies the one-time code for the key and the current time
+// step, accepting the number of time steps configured with WithSkew before
+// and after the current time step. It returns the time step counter of the
+// matching code, which allows the caller to reject reused codes.
+func VerifyTOTP(key []byte, code string, opts ...Option) (uint64, error) {
+	cfg, err := newConfig(opts)
+	if err != nil {
+		return 0, err
+	}
+
+	current, err := cfg.counter(cfg.now())
+	if err != nil {
+		return 0, err
+	}
+
+	for step := -cfg.skew; step <= cfg.skew; step++ {
+		if step < 0 && current < uint64(-step) {
+			continue
+		}
+
+		counter := current + uint64(step)
+		if equal(cfg.code(key, counter), code) {
+			return counter, nil
+		}
+	}
+
+	return 0, ErrInvalidCode
+}
+
+// counter returns the number of time steps between T0 and t. It is
+// calculated in seconds, since time.Duration overflows after 292 years.
+func (cfg config) counter(t time.Time) (uint64, error) {
+	if t.Before(cfg.t0) {
+		return 0, fmt.Errorf("time %s is before T0 %s", t, cfg.t0)
+	}
+
+	return uint64(t.Unix()-cfg.t0.Unix()) / uint64(cfg.period/time.Second), nil
+}
+
+// code computes the HMAC of the counter and encodes the truncated value.
+func (cfg config) code(key []byte, counter uint64) string {
+	mac := hmac.New(cfg.hash.New, key)
+	_ = binary.Write(mac, binary.BigEndian, counter)
+	sum := mac.Sum(nil)
+
+	// Dynamic truncation, RFC 4226 section 5.3, without masking the most
+	// significant bit.
+	offset := sum[len(sum)-1] & 0x0F
+	value := binary.BigEndian.Uint32(sum[offset:])
+
+	if cfg.bits == 16 {
+		return proquint.FromUint16(uint16(value), cfg.opts...)
+	}
+
+	return proquint.FromUint32(value, cfg.opts...)
+}
+
+func equal(want, got string) bool {
+	normalize := func(s string) []byte {
+		return []byte(strings.ToLower(strings.ReplaceAll(s, "-", "")))
+	}
+
+	return subtle.ConstantTimeCompare(normalize(want), normalize(got)) == 1
+}
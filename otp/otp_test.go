@@ -0,0 +1,249 @@
+package otp_test
+
+import (
+	"crypto"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	// Register the hash functions of the RFC 6238 test vectors.
+	_ "crypto/sha256"
+	_ "crypto/sha512"
+
+	"github.com/breml/proquint"
+	"github.com/breml/proquint/otp"
+)
+
+var rfc4226Key = []byte("12345678901234567890")
+
+// rfc4226Values are the truncated 31 bit values from RFC 4226, appendix D.
+var rfc4226Values = []uint32{
+	0x4c93cf18,
+	0x41397eea,
+	0x082fef30,
+	0x66ef7655,
+	0x61c5938a,
+	0x33c083d4,
+	0x7256c032,
+	0x04e5b397,
+	0x2823443f,
+	0x2679dc69,
+}
+
+func TestHOTP(t *testing.T) {
+	for counter, value := range rfc4226Values {
+		t.Run(fmt.Sprintf("counter %d", counter), func(t *testing.T) {
+			got, err := otp.HOTP(rfc4226Key, uint64(counter))
+			require.NoError(t, err)
+			full, err := proquint.ToUint32(got)
+			require.NoError(t, err)
+			require.Equal(t, value, full&0x7FFFFFFF)
+
+			got, err = otp.HOTP(rfc4226Key, uint64(counter), otp.WithBits(16))
+			require.NoError(t, err)
+			require.Equal(t, proquint.FromUint16(uint16(value)), got)
+
+			require.NoError(t, otp.VerifyHOTP(rfc4226Key, uint64(counter), got, otp.WithBits(16)))
+			require.ErrorIs(t, otp.VerifyHOTP(rfc4226Key, uint64(counter)+1, got, otp.WithBits(16)), otp.ErrInvalidCode)
+		})
+	}
+}
+
+func TestHOTPMostSignificantBit(t *testing.T) {
+	// The HMAC of counter 0 in RFC 4226, appendix D, is
+	// cc93cf18508d94934c64b65d8ba7667fb7cde4b0 with offset 0.
+	got, err := otp.HOTP(rfc4226Key, 0)
+	require.NoError(t, err)
+	require.Equal(t, proquint.FromUint32(0xcc93cf18), got)
+}
+
+func TestHOTPInvalidOptions(t *testing.T) {
+	tests := []struct {
+		name string
+		opts []otp.Option
+	}{
+		{
+			name: "invalid bits",
+			opts: []otp.Option{otp.WithBits(24)},
+		},
+		{
+			name: "hash not available",
+			opts: []otp.Option{otp.WithHash(crypto.MD4)},
+		},
+		{
+			name: "invalid period",
+			opts: []otp.Option{otp.WithPeriod(1500 * time.Millisecond)},
+		},
+		{
+			name: "invalid skew",
+			opts: []otp.Option{otp.WithSkew(-1)},
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			_, err := otp.HOTP(rfc4226Key, 0, test.opts...)
+			require.Error(t, err)
+		})
+	}
+}
+
+// TestTOTP verifies the codes against the test vectors of RFC 6238,
+// appendix B. The 8 digit codes of the RFC are the lower 31 bits reduced
+// modulo 10^8.
+func TestTOTP(t *testing.T) {
+	keys := map[crypto.Hash][]byte{
+		crypto.SHA1:   []byte("12345678901234567890"),
+		crypto.SHA256: []byte("12345678901234567890123456789012"),
+		crypto.SHA512: []byte("1234567890123456789012345678901234567890123456789012345678901234"),
+	}
+
+	tests := []struct {
+		time int64
+		hash crypto.Hash
+		want uint32
+	}{
+		{time: 59, hash: crypto.SHA1, want: 94287082},
+		{time: 59, hash: crypto.SHA256, want: 46119246},
+		{time: 59, hash: crypto.SHA512, want: 90693936},
+		{time: 1111111109, hash: crypto.SHA1, want: 7081804},
+		{time: 1111111109, hash: crypto.SHA256, want: 68084774},
+		{time: 1111111109, hash: crypto.SHA512, want: 25091201},
+		{time: 1111111111, hash: crypto.SHA1, want: 14050471},
+		{time: 1111111111, hash: crypto.SHA256, want: 67062674},
+		{time: 1111111111, hash: crypto.SHA512, want: 99943326},
+		{time: 1234567890, hash: crypto.SHA1, want: 89005924},
+		{time: 1234567890, hash: crypto.SHA256, want: 91819424},
+		{time: 1234567890, hash: crypto.SHA512, want: 93441116},
+		{time: 2000000000, hash: crypto.SHA1, want: 69279037},
+		{time: 2000000000, hash: crypto.SHA256, want: 90698825},
+		{time: 2000000000, hash: crypto.SHA512, want: 38618901},
+		{time: 20000000000, hash: crypto.SHA1, want: 65353130},
+		{time: 20000000000, hash: crypto.SHA256, want: 77737706},
+		{time: 20000000000, hash: crypto.SHA512, want: 47863826},
+	}
+
+	for _, test := range tests {
+		t.Run(fmt.Sprintf("%d %s", test.time, test.hash), func(t *testing.T) {
+			now := func() time.Time { return time.Unix(test.time, 0) }
+
+			code, err := otp.TOTP(keys[test.hash], otp.WithHash(test.hash), otp.WithClock(now))
+			require.NoError(t, err)
+
+			value, err := proquint.ToUint32(code)
+			require.NoError(t, err)
+			require.Equal(t, test.want, (value&0x7FFFFFFF)%100_000_000)
+
+			counter, err := otp.VerifyTOTP(keys[test.hash], code, otp.WithHash(test.hash), otp.WithClock(now))
+			require.NoError(t, err)
+			require.Equal(t, uint64(test.time/30), counter)
+		})
+	}
+}
+
+func TestVerifyTOTPSkew(t *testing.T) {
+	now := time.Unix(1111111111, 0)
+	clock := func() time.Time { return now }
+
+	code, err := otp.TOTP(rfc4226Key, otp.WithClock(clock), otp.WithEncodingOptions(proquint.WithHyphens()))
+	require.NoError(t, err)
+
+	tests := []struct {
+		name string
+		now  time.Time
+		opts []otp.Option
+
+		assertErr   require.ErrorAssertionFunc
+		wantCounter uint64
+	}{
+		{
+			name: "same step",
+			now:  now,
+
+			assertErr:   require.NoError,
+			wantCounter: 37037037,
+		},
+		{
+			name: "previous step",
+			now:  now.Add(-30 * time.Second),
+
+			assertErr:   require.NoError,
+			wantCounter: 37037037,
+		},
+		{
+			name: "next step",
+			now:  now.Add(30 * time.Second),
+
+			assertErr:   require.NoError,
+			wantCounter: 37037037,
+		},
+		{
+			name: "outside skew",
+			now:  now.Add(60 * time.Second),
+
+			assertErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorIs(t, err, otp.ErrInvalidCode)
+			},
+		},
+		{
+			name: "larger skew",
+			now:  now.Add(60 * time.Second),
+			opts: []otp.Option{otp.WithSkew(2)},
+
+			assertErr:   require.NoError,
+			wantCounter: 37037037,
+		},
+		{
+			name: "no skew",
+			now:  now.Add(30 * time.Second),
+			opts: []otp.Option{otp.WithSkew(0)},
+
+			assertErr: func(t require.TestingT, err error, _ ...any) {
+				require.ErrorIs(t, err, otp.ErrInvalidCode)
+			},
+		},
+		{
+			name: "before T0",
+			now:  now,
+			opts: []otp.Option{otp.WithT0(now.Add(time.Hour))},
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := append([]otp.Option{otp.WithClock(func() time.Time { return test.now })}, test.opts...)
+
+			counter, err := otp.VerifyTOTP(rfc4226Key, code, opts...)
+			test.assertErr(t, err)
+
+			require.Equal(t, test.wantCounter, counter)
+		})
+	}
+}
+
+func TestVerifyTOTPNormalizes(t *testing.T) {
+	clock := func() time.Time { return time.Unix(59, 0) }
+
+	code, err := otp.TOTP(rfc4226Key, otp.WithClock(clock), otp.WithEncodingOptions(proquint.WithHyphens()))
+	require.NoError(t, err)
+
+	for _, in := range []string{code, strings.ToUpper(code), strings.ReplaceAll(code, "-", "")} {
+		_, err := otp.VerifyTOTP(rfc4226Key, in, otp.WithClock(clock))
+		require.NoError(t, err)
+	}
+}
+
+func ExampleHOTP() {
+	code, err := otp.HOTP([]byte("12345678901234567890"), 0, otp.WithEncodingOptions(proquint.WithHyphens()))
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(code)
+	// Output: sufig-susim
+}
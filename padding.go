@@ -0,0 +1,213 @@
+package proquint
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Padding defines how an input with an odd number of bytes is encoded by
+// FromBytes and how the padding is removed again by ToBytes. Passing the
+// same Padding to WithEncodingPadding and WithDecodingPadding guarantees,
+// that the encoded proquint decodes to the original input, with the
+// exception of ZeroBytePadding, which is lossy. A Codec configures the
+// Padding of both directions at once.
+type Padding interface {
+	// Pad is called by FromBytes with the raw input. It returns the bytes,
+	// which are encoded as proquint syllables and therefore must have an even
+	// length, as well as a suffix, which is appended to the encoded proquint.
+	// The input must not be modified.
+	Pad(in []byte) (body []byte, suffix string, err error)
+
+	// Unpad is called by ToBytes with the decoded bytes of all complete
+	// syllables and the remaining suffix of the proquint (lower case
+	// letters not forming a complete syllable, followed by an optional
+	// final hyphen). It returns the original input. The returned slice may
+	// share the backing array of body. On error, body is wiped, since it may
+	// contain secret data.
+	Unpad(body []byte, suffix string) ([]byte, error)
+}
+
+var (
+	// NoPadding does not pad the input. Encoding an odd number of bytes
+	// results in an error. This is the default, if no padding is configured.
+	NoPadding Padding = noPadding{}
+
+	// ZeroBytePadding adds a single 0x00 byte (padding byte) to the end of an
+	// input with an odd number of bytes. While decoding, a final 0x00 byte is
+	// always treated as padding and therefore removed. This padding is lossy:
+	// an input with an even number of bytes ending with a 0x00 byte does not
+	// decode to the original input, e.g. 0x01 0x00 decodes to 0x01. Use
+	// FinalHyphenPadding, LengthPrefixPadding or HalfSyllablePadding, if the
+	// input may end with a 0x00 byte.
+	ZeroBytePadding Padding = zeroBytePadding{}
+
+	// FinalHyphenPadding adds a single 0x00 byte (padding byte) to the end of
+	// an input with an odd number of bytes and signals the padding by ending
+	// the encoded proquint with a final hyphen:
+	//
+	//	bahaf-basab-
+	FinalHyphenPadding Padding = finalHyphenPadding{}
+
+	// LengthPrefixPadding prepends a syllable containing the length of the
+	// input in bytes and adds a 0x00 byte to the end of an input with an odd
+	// number of bytes. The input is limited to 65535 bytes.
+	//
+	//	babag-bahaf-basab
+	LengthPrefixPadding Padding = lengthPrefixPadding{}
+
+	// HalfSyllablePadding encodes the final byte of an input with an odd
+	// number of bytes as a half syllable, consisting of the first three
+	// letters of the syllable with the byte in the upper half:
+	//
+	//	bahaf-bas
+	HalfSyllablePadding Padding = halfSyllablePadding{}
+)
+
+type noPadding struct{}
+
+func (noPadding) Pad(in []byte) ([]byte, string, error) {
+	if len(in)%2 != 0 {
+		return nil, "", fmt.Errorf("only arguments with even length are supported")
+	}
+
+	return in, "", nil
+}
+
+func (noPadding) Unpad(body []byte, suffix string) ([]byte, error) {
+	if strings.TrimSuffix(suffix, "-") != "" {
+		clear(body)
+		return nil, fmt.Errorf("invalid proquint, length not multiple of 5")
+	}
+
+	return body, nil
+}
+
+type zeroBytePadding struct{}
+
+func (zeroBytePadding) Pad(in []byte) ([]byte, string, error) {
+	return padZeroByte(in), "", nil
+}
+
+func (zeroBytePadding) Unpad(body []byte, suffix string) ([]byte, error) {
+	body, err := NoPadding.Unpad(body, suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) > 0 && body[len(body)-1] == 0x00 {
+		// Strip final byte, since it is 0x00 and padding is enabled.
+		body = body[:len(body)-1]
+	}
+
+	return body, nil
+}
+
+type finalHyphenPadding struct{}
+
+func (finalHyphenPadding) Pad(in []byte) ([]byte, string, error) {
+	if len(in)%2 == 0 {
+		return in, "", nil
+	}
+
+	return padZeroByte(in), "-", nil
+}
+
+func (finalHyphenPadding) Unpad(body []byte, suffix string) ([]byte, error) {
+	body, err := NoPadding.Unpad(body, suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	if suffix == "-" && len(body) > 0 && body[len(body)-1] == 0x00 {
+		// Strip final byte, since it is 0x00 and signaled by the final hyphen.
+		body = body[:len(body)-1]
+	}
+
+	return body, nil
+}
+
+type lengthPrefixPadding struct{}
+
+func (lengthPrefixPadding) Pad(in []byte) ([]byte, string, error) {
+	if len(in) > 0xFFFF {
+		return nil, "", fmt.Errorf("input of %d bytes exceeds maximum length of %d bytes for length prefix padding", len(in), 0xFFFF)
+	}
+
+	body := make([]byte, 0, len(in)+3)
+	body = append(body, byte(len(in)>>8), byte(len(in)))
+	body = append(body, in...)
+
+	return padZeroByte(body), "", nil
+}
+
+func (lengthPrefixPadding) Unpad(body []byte, suffix string) ([]byte, error) {
+	body, err := NoPadding.Unpad(body, suffix)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(body) < 2 {
+		clear(body)
+		return nil, fmt.Errorf("invalid proquint, length prefix missing")
+	}
+
+	length := int(body[0])<<8 + int(body[1])
+	data := body[2:]
+
+	switch {
+	case len(data) == length:
+	case len(data) == length+1 && data[length] == 0x00:
+		data = data[:length]
+	default:
+		clear(body)
+		return nil, fmt.Errorf("invalid proquint, length prefix %d does not match %d bytes of data", length, len(data))
+	}
+
+	return data, nil
+}
+
+type halfSyllablePadding struct{}
+
+func (halfSyllablePadding) Pad(in []byte) ([]byte, string, error) {
+	if len(in)%2 == 0 {
+		return in, "", nil
+	}
+
+	return in[:len(in)-1], FromUint16(uint16(in[len(in)-1]) << 8)[:3], nil
+}
+
+func (halfSyllablePadding) Unpad(body []byte, suffix string) ([]byte, error) {
+	suffix = strings.TrimSuffix(suffix, "-")
+	if suffix == "" {
+		return body, nil
+	}
+
+	if len(suffix) != 3 {
+		clear(body)
+		return nil, fmt.Errorf("invalid proquint, half syllable %q does not have 3 characters", suffix)
+	}
+
+	// Complete the half syllable with the letters representing zero bits.
+	ui16, err := decodeSyllable(suffix + string([]byte{vowel[0], consonants[0]}))
+	if err != nil {
+		clear(body)
+		return nil, err
+	}
+
+	if ui16&0x00FF != 0 {
+		clear(body)
+		return nil, fmt.Errorf("invalid half syllable %q, lower bits are not zero", suffix)
+	}
+
+	return append(body, byte(ui16>>8)), nil
+}
+
+// padZeroByte returns in with a 0x00 byte appended, if in has an odd number
+// of bytes. The backing array of in is never modified.
+func padZeroByte(in []byte) []byte {
+	if len(in)%2 == 0 {
+		return in
+	}
+
+	return append(in[:len(in):len(in)], 0x00)
+}
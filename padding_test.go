@@ -0,0 +1,278 @@
+package proquint_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestPadding(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      []byte
+		padding proquint.Padding
+
+		assertErr require.ErrorAssertionFunc
+		want      string
+
+		// wantDecoded is the decoded value, if it differs from in.
+		wantDecoded []byte
+	}{
+		{
+			name:    "no padding - even",
+			in:      []byte{1, 2, 3, 4},
+			padding: proquint.NoPadding,
+
+			assertErr: require.NoError,
+			want:      "bahaf-basah",
+		},
+		{
+			name:    "no padding - odd",
+			in:      []byte{1, 2, 3},
+			padding: proquint.NoPadding,
+
+			assertErr: require.Error,
+		},
+		{
+			name:    "zero byte - odd",
+			in:      []byte{1, 2, 3},
+			padding: proquint.ZeroBytePadding,
+
+			assertErr: require.NoError,
+			want:      "bahaf-basab",
+		},
+		{
+			name:    "zero byte - even with final zero byte is lossy",
+			in:      []byte{1, 0},
+			padding: proquint.ZeroBytePadding,
+
+			assertErr:   require.NoError,
+			want:        "bahab",
+			wantDecoded: []byte{1},
+		},
+		{
+			name:    "final hyphen - odd",
+			in:      []byte{1, 2, 3},
+			padding: proquint.FinalHyphenPadding,
+
+			assertErr: require.NoError,
+			want:      "bahaf-basab-",
+		},
+		{
+			name:    "final hyphen - even with final zero byte",
+			in:      []byte{1, 2, 3, 0},
+			padding: proquint.FinalHyphenPadding,
+
+			assertErr: require.NoError,
+			want:      "bahaf-basab",
+		},
+		{
+			name:    "length prefix - odd",
+			in:      []byte{1, 2, 3},
+			padding: proquint.LengthPrefixPadding,
+
+			assertErr: require.NoError,
+			want:      "babag-bahaf-basab",
+		},
+		{
+			name:    "length prefix - even with final zero byte",
+			in:      []byte{1, 2, 3, 0},
+			padding: proquint.LengthPrefixPadding,
+
+			assertErr: require.NoError,
+			want:      "babah-bahaf-basab",
+		},
+		{
+			name:    "length prefix - empty",
+			in:      []byte{},
+			padding: proquint.LengthPrefixPadding,
+
+			assertErr: require.NoError,
+			want:      "babab",
+		},
+		{
+			name:    "half syllable - odd",
+			in:      []byte{1, 2, 3},
+			padding: proquint.HalfSyllablePadding,
+
+			assertErr: require.NoError,
+			want:      "bahaf-bas",
+		},
+		{
+			name:    "half syllable - single byte",
+			in:      []byte{0xFF},
+			padding: proquint.HalfSyllablePadding,
+
+			assertErr: require.NoError,
+			want:      "zus",
+		},
+		{
+			name:    "half syllable - even with final zero byte",
+			in:      []byte{1, 2, 3, 0},
+			padding: proquint.HalfSyllablePadding,
+
+			assertErr: require.NoError,
+			want:      "bahaf-basab",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			// Spare capacity filled with a marker byte to detect writes beyond the input.
+			buf := append(append([]byte{}, tc.in...), 0xAA)
+			in := buf[:len(tc.in)]
+
+			quint, err := proquint.FromBytes(in, proquint.WithEncodingPadding(tc.padding), proquint.WithHyphens())
+			tc.assertErr(t, err)
+			require.Equal(t, tc.want, quint)
+			require.Equal(t, byte(0xAA), buf[len(tc.in)], "backing array of input must not be modified")
+
+			if err != nil {
+				return
+			}
+
+			want := tc.in
+			if tc.wantDecoded != nil {
+				want = tc.wantDecoded
+			}
+
+			got, err := proquint.ToBytes(quint, proquint.WithDecodingPadding(tc.padding))
+			require.NoError(t, err)
+			require.Equal(t, want, got)
+		})
+	}
+}
+
+func TestPaddingDecodeErrors(t *testing.T) {
+	tests := []struct {
+		name    string
+		in      string
+		padding proquint.Padding
+	}{
+		{
+			name:    "no padding - partial syllable",
+			in:      "bahaf-bas",
+			padding: proquint.NoPadding,
+		},
+		{
+			name:    "length prefix - missing",
+			in:      "",
+			padding: proquint.LengthPrefixPadding,
+		},
+		{
+			name:    "length prefix - mismatch",
+			in:      "babaz-bahaf-basab",
+			padding: proquint.LengthPrefixPadding,
+		},
+		{
+			name:    "half syllable - wrong length",
+			in:      "bahaf-ba",
+			padding: proquint.HalfSyllablePadding,
+		},
+		{
+			name:    "half syllable - lower bits set",
+			in:      "bahaf-bad",
+			padding: proquint.HalfSyllablePadding,
+		},
+		{
+			name:    "half syllable - invalid letter",
+			in:      "bahaf-bXs",
+			padding: proquint.HalfSyllablePadding,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := proquint.ToBytes(tc.in, proquint.WithDecodingPadding(tc.padding))
+			require.Error(t, err)
+		})
+	}
+}
+
+func TestUnpadWipesBodyOnError(t *testing.T) {
+	tests := []struct {
+		name    string
+		padding proquint.Padding
+		suffix  string
+	}{
+		{
+			name:    "no padding",
+			padding: proquint.NoPadding,
+			suffix:  "bas",
+		},
+		{
+			name:    "zero byte",
+			padding: proquint.ZeroBytePadding,
+			suffix:  "bas",
+		},
+		{
+			name:    "final hyphen",
+			padding: proquint.FinalHyphenPadding,
+			suffix:  "bas-",
+		},
+		{
+			name:    "length prefix",
+			padding: proquint.LengthPrefixPadding,
+		},
+		{
+			name:    "half syllable - wrong length",
+			padding: proquint.HalfSyllablePadding,
+			suffix:  "ba",
+		},
+		{
+			name:    "half syllable - lower bits set",
+			padding: proquint.HalfSyllablePadding,
+			suffix:  "lut",
+		},
+		{
+			name:    "half syllable - invalid letter",
+			padding: proquint.HalfSyllablePadding,
+			suffix:  "bXs",
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			body := []byte{0x00, 0x05, 0x01, 0x02}
+
+			_, err := tc.padding.Unpad(body, tc.suffix)
+			require.Error(t, err)
+			require.Equal(t, make([]byte, len(body)), body)
+		})
+	}
+}
+
+// failingPadding records the body passed to Unpad and fails.
+type failingPadding struct {
+	body []byte
+}
+
+func (p *failingPadding) Pad(in []byte) ([]byte, string, error) {
+	return proquint.NoPadding.Pad(in)
+}
+
+func (p *failingPadding) Unpad(body []byte, _ string) ([]byte, error) {
+	p.body = body
+	return nil, errors.New("unpad failed")
+}
+
+func TestToBytesWipesOnPaddingError(t *testing.T) {
+	padding := &failingPadding{}
+
+	_, err := proquint.ToBytes("bahaf-basah", proquint.WithDecodingPadding(padding))
+	require.Error(t, err)
+	require.Equal(t, make([]byte, 4), padding.body, "decoded bytes must be wiped, even if the padding does not")
+}
+
+func TestHalfSyllablePaddingDecodesInPlace(t *testing.T) {
+	got, err := proquint.ToBytes("bahaf-bas", proquint.WithDecodingPadding(proquint.HalfSyllablePadding))
+	require.NoError(t, err)
+	require.Equal(t, []byte{1, 2, 3}, got)
+
+	// The final byte is appended without copying the decoded bytes to a new
+	// backing array, which would leave an unwiped copy behind.
+	require.Equal(t, len(got), cap(got))
+}
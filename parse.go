@@ -0,0 +1,220 @@
+package proquint
+
+import (
+	"encoding/binary"
+	"net"
+	"net/netip"
+
+	"github.com/google/uuid"
+)
+
+// Value is a decoded proquint of unknown type. It provides the candidate
+// interpretations based on the number of syllables or, if parsed with
+// WithParseTypeTag, based on the registered type of the type tag.
+type Value struct {
+	bytes []byte
+
+	typ    Type
+	tagged bool
+}
+
+// Candidate is a possible interpretation of a Value.
+type Candidate struct {
+	// Type is the name of the interpretation, e.g. "uint32" or "ipv4".
+	Type string
+
+	// Value is the typed value, e.g. uint32 or netip.Addr.
+	Value any
+}
+
+// WithParseTypeTag expects a leading type-tag syllable of a registered type,
+// see RegisterType. Parse records the type in the returned Value. The option
+// is only used by Parse, use ToTagged for the other decoding functions.
+func WithParseTypeTag() DecodingOption {
+	return func(cfg *decodingConfig) {
+		cfg.parseTypeTag = true
+	}
+}
+
+// Parse decodes a proquint string of unknown type.
+func Parse(in string, opts ...DecodingOption) (Value, error) {
+	cfg := decodingConfig{}
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.parseTypeTag {
+		typ, res, err := ToTagged(in, opts...)
+		if err != nil {
+			return Value{}, err
+		}
+
+		return Value{bytes: res, typ: typ, tagged: true}, nil
+	}
+
+	res, err := ToBytes(in, opts...)
+	if err != nil {
+		return Value{}, err
+	}
+
+	return Value{bytes: res}, nil
+}
+
+// Type returns the registered type of the value, if it has been parsed with
+// WithParseTypeTag.
+func (v Value) Type() (Type, bool) {
+	return v.typ, v.tagged
+}
+
+// Bytes returns a copy of the decoded bytes.
+func (v Value) Bytes() []byte {
+	return append([]byte(nil), v.bytes...)
+}
+
+// Syllables returns the number of proquint syllables.
+func (v Value) Syllables() int {
+	return syllables(len(v.bytes))
+}
+
+// String returns the value encoded as proquint with hyphens, including the
+// type-tag syllable, if the value has been parsed with WithParseTypeTag.
+func (v Value) String() string {
+	opts := []EncodingOption{WithHyphens(), WithEncodingPadding(HalfSyllablePadding)}
+	if v.tagged {
+		opts = append(opts, WithTypeTag(v.typ.Tag))
+	}
+
+	quint, err := FromBytes(v.bytes, opts...)
+	if err != nil {
+		return ""
+	}
+
+	return quint
+}
+
+// Uint16 returns the value as uint16, if it consists of a single syllable.
+func (v Value) Uint16() (uint16, bool) {
+	if len(v.bytes) != 2 {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint16(v.bytes), true
+}
+
+// Uint32 returns the value as uint32, if it consists of two syllables.
+func (v Value) Uint32() (uint32, bool) {
+	if len(v.bytes) != 4 {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint32(v.bytes), true
+}
+
+// Uint64 returns the value as uint64, if it consists of four syllables.
+func (v Value) Uint64() (uint64, bool) {
+	if len(v.bytes) != 8 {
+		return 0, false
+	}
+
+	return binary.BigEndian.Uint64(v.bytes), true
+}
+
+// Addr returns the value as IPv4 address, if it consists of two syllables,
+// or as IPv6 address, if it consists of eight syllables.
+func (v Value) Addr() (netip.Addr, bool) {
+	return netip.AddrFromSlice(v.bytes)
+}
+
+// AddrPort returns the value as IPv4 address and port, if it consists of
+// three syllables.
+func (v Value) AddrPort() (netip.AddrPort, bool) {
+	if len(v.bytes) != 6 {
+		return netip.AddrPort{}, false
+	}
+
+	addr, _ := netip.AddrFromSlice(v.bytes[:4])
+
+	return netip.AddrPortFrom(addr, binary.BigEndian.Uint16(v.bytes[4:])), true
+}
+
+// HardwareAddr returns the value as MAC address, if it consists of three
+// syllables, or as EUI-64, if it consists of four syllables.
+func (v Value) HardwareAddr() (net.HardwareAddr, bool) {
+	if len(v.bytes) != 6 && len(v.bytes) != 8 {
+		return nil, false
+	}
+
+	return net.HardwareAddr(v.Bytes()), true
+}
+
+// UUID returns the value as UUID, if it consists of eight syllables.
+func (v Value) UUID() (uuid.UUID, bool) {
+	id, err := uuid.FromBytes(v.bytes)
+	if err != nil {
+		return uuid.UUID{}, false
+	}
+
+	return id, true
+}
+
+// Candidates returns all possible interpretations of the value. For a value
+// parsed with WithParseTypeTag, only the interpretation matching the name of
+// the registered type is returned. If there is no such interpretation, the
+// only candidate contains the raw bytes.
+func (v Value) Candidates() []Candidate {
+	if v.tagged {
+		for _, candidate := range v.candidates() {
+			if candidate.Type == v.typ.Name {
+				return []Candidate{candidate}
+			}
+		}
+
+		return []Candidate{{Type: v.typ.Name, Value: v.Bytes()}}
+	}
+
+	return v.candidates()
+}
+
+func (v Value) candidates() []Candidate {
+	var candidates []Candidate
+
+	if ui16, ok := v.Uint16(); ok {
+		candidates = append(candidates, Candidate{Type: "uint16", Value: ui16})
+	}
+
+	if ui32, ok := v.Uint32(); ok {
+		candidates = append(candidates, Candidate{Type: "uint32", Value: ui32})
+	}
+
+	if ui64, ok := v.Uint64(); ok {
+		candidates = append(candidates, Candidate{Type: "uint64", Value: ui64})
+	}
+
+	if addr, ok := v.Addr(); ok {
+		typ := "ipv6"
+		if addr.Is4() {
+			typ = "ipv4"
+		}
+
+		candidates = append(candidates, Candidate{Type: typ, Value: addr})
+	}
+
+	if addrPort, ok := v.AddrPort(); ok {
+		candidates = append(candidates, Candidate{Type: "ipv4:port", Value: addrPort})
+	}
+
+	if mac, ok := v.HardwareAddr(); ok {
+		typ := "mac"
+		if len(mac) == 8 {
+			typ = "eui64"
+		}
+
+		candidates = append(candidates, Candidate{Type: typ, Value: mac})
+	}
+
+	if id, ok := v.UUID(); ok {
+		candidates = append(candidates, Candidate{Type: "uuid", Value: id})
+	}
+
+	return candidates
+}
@@ -0,0 +1,173 @@
+package proquint_test
+
+import (
+	"fmt"
+	"net"
+	"net/netip"
+	"testing"
+
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestParse(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+
+		assertErr      require.ErrorAssertionFunc
+		wantSyllables  int
+		wantCandidates []proquint.Candidate
+	}{
+		{
+			name: "1 syllable",
+			in:   "damuh",
+
+			assertErr:     require.NoError,
+			wantSyllables: 1,
+			wantCandidates: []proquint.Candidate{
+				{Type: "uint16", Value: uint16(0x1234)},
+			},
+		},
+		{
+			name: "2 syllables",
+			in:   "lusab-babad",
+
+			assertErr:     require.NoError,
+			wantSyllables: 2,
+			wantCandidates: []proquint.Candidate{
+				{Type: "uint32", Value: uint32(0x7F000001)},
+				{Type: "ipv4", Value: netip.MustParseAddr("127.0.0.1")},
+			},
+		},
+		{
+			name: "3 syllables",
+			in:   "lusab-babad-gutih",
+
+			assertErr:     require.NoError,
+			wantSyllables: 3,
+			wantCandidates: []proquint.Candidate{
+				{Type: "ipv4:port", Value: netip.MustParseAddrPort("127.0.0.1:16212")},
+				{Type: "mac", Value: net.HardwareAddr{0x7F, 0x00, 0x00, 0x01, 0x3F, 0x54}},
+			},
+		},
+		{
+			name: "4 syllables",
+			in:   "bahaf-basah-bihak-bisam",
+
+			assertErr:     require.NoError,
+			wantSyllables: 4,
+			wantCandidates: []proquint.Candidate{
+				{Type: "uint64", Value: uint64(0x0102030405060708)},
+				{Type: "eui64", Value: net.HardwareAddr{0x01, 0x02, 0x03, 0x04, 0x05, 0x06, 0x07, 0x08}},
+			},
+		},
+		{
+			name: "8 syllables",
+			in:   "kivaf-damur-zabal-hilup-pokum-figib-datoz-pugih",
+
+			assertErr:     require.NoError,
+			wantSyllables: 8,
+			wantCandidates: []proquint.Candidate{
+				{Type: "ipv6", Value: netip.MustParseAddr("6782:123b:f007:45fa:a9b8:24d0:136f:acd4")},
+				{Type: "uuid", Value: uuid.MustParse("6782123b-f007-45fa-a9b8-24d0136facd4")},
+			},
+		},
+		{
+			name: "5 syllables",
+			in:   "bahaf-basah-bihak-bisam-babab",
+
+			assertErr:     require.NoError,
+			wantSyllables: 5,
+		},
+		{
+			name: "error - invalid character",
+			in:   "lusab-baXad",
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := proquint.Parse(tc.in)
+			tc.assertErr(t, err)
+
+			require.Equal(t, tc.wantSyllables, value.Syllables())
+			require.Equal(t, tc.wantCandidates, value.Candidates())
+
+			if err == nil {
+				require.Equal(t, tc.in, value.String())
+			}
+		})
+	}
+}
+
+func TestParseTypeTag(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+
+		assertErr      require.ErrorAssertionFunc
+		wantType       proquint.Type
+		wantCandidates []proquint.Candidate
+	}{
+		{
+			name: "ipv4",
+			in:   "babad-lusab-babad",
+
+			assertErr:      require.NoError,
+			wantType:       proquint.Type{Tag: tagTestIPv4, Name: "ipv4", Size: 4},
+			wantCandidates: []proquint.Candidate{{Type: "ipv4", Value: netip.MustParseAddr("127.0.0.1")}},
+		},
+		{
+			name: "type without interpretation",
+			in:   "babaf-lusab-babad",
+
+			assertErr:      require.NoError,
+			wantType:       proquint.Type{Tag: tagTestUserID, Name: "user-id", Size: 4},
+			wantCandidates: []proquint.Candidate{{Type: "user-id", Value: []byte{0x7F, 0x00, 0x00, 0x01}}},
+		},
+		{
+			name: "error - unregistered type tag",
+			in:   "zabaf-lusab-babad",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "error - invalid payload size",
+			in:   "babad-lusab",
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			value, err := proquint.Parse(tc.in, proquint.WithParseTypeTag())
+			tc.assertErr(t, err)
+
+			typ, ok := value.Type()
+			require.Equal(t, err == nil, ok)
+			require.Equal(t, tc.wantType, typ)
+			require.Equal(t, tc.wantCandidates, value.Candidates())
+
+			if err == nil {
+				require.Equal(t, tc.in, value.String())
+			}
+		})
+	}
+}
+
+func ExampleParse() {
+	value, _ := proquint.Parse("lusab-babad")
+
+	for _, candidate := range value.Candidates() {
+		fmt.Println(candidate.Type, candidate.Value)
+	}
+	// Output:
+	// uint32 2130706433
+	// ipv4 127.0.0.1
+}
@@ -0,0 +1,248 @@
+// Package passphrase generates memorable passphrases from proquint
+// syllables and estimates the strength of proquint passphrases.
+//
+// Each randomly chosen proquint syllable carries 16 bits of entropy.
+package passphrase
+
+import (
+	"crypto/rand"
+	"fmt"
+	"io"
+	"strings"
+	"unicode"
+
+	"github.com/breml/proquint"
+)
+
+// BitsPerSyllable is the entropy of a uniformly random proquint syllable.
+const BitsPerSyllable = 16
+
+// Case defines the letter case of a generated passphrase.
+type Case int
+
+const (
+	// Lower uses lower case letters only: lusab-babad
+	Lower Case = iota
+
+	// Upper uses upper case letters only: LUSAB-BABAD
+	Upper
+
+	// Title capitalizes the first letter of each syllable: Lusab-Babad
+	Title
+)
+
+// Passphrase is a generated passphrase.
+type Passphrase struct {
+	// Text is the formatted passphrase.
+	Text string
+
+	// Syllables is the number of proquint syllables.
+	Syllables int
+
+	// Entropy is the entropy of the passphrase in bits.
+	Entropy float64
+}
+
+type config struct {
+	separator  string
+	letterCase Case
+	random     io.Reader
+}
+
+type Option func(*config)
+
+// WithSeparator sets the separator between the syllables. The default is
+// a hyphen.
+func WithSeparator(separator string) Option {
+	return func(cfg *config) {
+		cfg.separator = separator
+	}
+}
+
+// WithCase sets the letter case. The default is Lower.
+func WithCase(letterCase Case) Option {
+	return func(cfg *config) {
+		cfg.letterCase = letterCase
+	}
+}
+
+// WithRandom sets the source of the random bytes. The default is
+// crypto/rand.Reader.
+func WithRandom(random io.Reader) Option {
+	return func(cfg *config) {
+		cfg.random = random
+	}
+}
+
+// Generate returns a new random passphrase with at least the given entropy
+// in bits.
+func Generate(entropyBits int, opts ...Option) (Passphrase, error) {
+	cfg := config{
+		separator: "-",
+		random:    rand.Reader,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if entropyBits < 1 {
+		return Passphrase{}, fmt.Errorf("invalid entropy of %d bits", entropyBits)
+	}
+
+	syllables := (entropyBits + BitsPerSyllable - 1) / BitsPerSyllable
+
+	random := make([]byte, syllables*2)
+	_, err := io.ReadFull(cfg.random, random)
+	if err != nil {
+		return Passphrase{}, fmt.Errorf("failed to read random bytes: %w", err)
+	}
+
+	parts := make([]string, 0, syllables)
+	for i := 0; i < len(random); i += 2 {
+		syllable := proquint.FromUint16(uint16(random[i])<<8 | uint16(random[i+1]))
+
+		switch cfg.letterCase {
+		case Upper:
+			syllable = strings.ToUpper(syllable)
+		case Title:
+			syllable = strings.ToUpper(syllable[:1]) + syllable[1:]
+		}
+
+		parts = append(parts, syllable)
+	}
+
+	return Passphrase{
+		Text:      strings.Join(parts, cfg.separator),
+		Syllables: syllables,
+		Entropy:   float64(syllables * BitsPerSyllable),
+	}, nil
+}
+
+// Score rates the strength of a passphrase.
+type Score int
+
+const (
+	VeryWeak Score = iota
+	Weak
+	Fair
+	Strong
+	VeryStrong
+)
+
+func (s Score) String() string {
+	switch s {
+	case VeryWeak:
+		return "very weak"
+	case Weak:
+		return "weak"
+	case Fair:
+		return "fair"
+	case Strong:
+		return "strong"
+	case VeryStrong:
+		return "very strong"
+	default:
+		return fmt.Sprintf("Score(%d)", int(s))
+	}
+}
+
+// Strength is the estimated strength of a passphrase.
+type Strength struct {
+	// Syllables is the number of proquint syllables.
+	Syllables int
+
+	// Entropy is the estimated entropy of the passphrase in bits.
+	Entropy float64
+
+	// Score rates the estimated entropy.
+	Score Score
+
+	// Warnings explain, why the estimated entropy is lower than the
+	// entropy of a random passphrase with the same number of syllables.
+	Warnings []string
+}
+
+// lowEntropyBits is the estimated entropy of a syllable following a simple
+// pattern, like repeating the same consonant and vowel: babab
+const lowEntropyBits = 6
+
+// Check estimates the strength of a user supplied proquint passphrase. The
+// syllables may be separated by any non-letter characters, case is ignored.
+// Each syllable is assumed to carry 16 bits of entropy, except repeated
+// syllables, which do not add any entropy, and syllables following a simple
+// pattern.
+func Check(in string) (Strength, error) {
+	letters := strings.ToLower(strings.Map(func(r rune) rune {
+		if unicode.IsLetter(r) {
+			return r
+		}
+
+		return -1
+	}, in))
+
+	if letters == "" || len(letters)%5 != 0 {
+		return Strength{}, fmt.Errorf("invalid passphrase, %d letters are not a multiple of 5", len(letters))
+	}
+
+	strength := Strength{
+		Syllables: len(letters) / 5,
+	}
+
+	seen := map[string]bool{}
+	repeated, patterns := 0, 0
+
+	for i := 0; i < len(letters); i += 5 {
+		syllable := letters[i : i+5]
+
+		_, err := proquint.ToUint16(syllable)
+		if err != nil {
+			return Strength{}, fmt.Errorf("invalid passphrase: %w", err)
+		}
+
+		switch {
+		case seen[syllable]:
+			repeated++
+		case isPattern(syllable):
+			patterns++
+			strength.Entropy += lowEntropyBits
+		default:
+			strength.Entropy += BitsPerSyllable
+		}
+
+		seen[syllable] = true
+	}
+
+	if repeated > 0 {
+		strength.Warnings = append(strength.Warnings, fmt.Sprintf("%d repeated syllables", repeated))
+	}
+
+	if patterns > 0 {
+		strength.Warnings = append(strength.Warnings, fmt.Sprintf("%d syllables with repeated letters", patterns))
+	}
+
+	strength.Score = score(strength.Entropy)
+
+	return strength, nil
+}
+
+// isPattern reports, if the syllable repeats the same consonant and the same
+// vowel, e.g. babab or zuzuz.
+func isPattern(syllable string) bool {
+	return syllable[0] == syllable[2] && syllable[2] == syllable[4] && syllable[1] == syllable[3]
+}
+
+func score(entropy float64) Score {
+	switch {
+	case entropy < 32:
+		return VeryWeak
+	case entropy < 48:
+		return Weak
+	case entropy < 64:
+		return Fair
+	case entropy < 96:
+		return Strong
+	default:
+		return VeryStrong
+	}
+}
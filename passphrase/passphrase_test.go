@@ -0,0 +1,191 @@
+package passphrase_test
+
+import (
+	"bytes"
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint/passphrase"
+)
+
+func TestGenerate(t *testing.T) {
+	random := []byte{0x7F, 0x00, 0x00, 0x01, 0xFF, 0xFF}
+
+	tests := []struct {
+		name        string
+		entropyBits int
+		opts        []passphrase.Option
+
+		assertErr require.ErrorAssertionFunc
+		want      passphrase.Passphrase
+	}{
+		{
+			name:        "single syllable",
+			entropyBits: 16,
+
+			assertErr: require.NoError,
+			want:      passphrase.Passphrase{Text: "lusab", Syllables: 1, Entropy: 16},
+		},
+		{
+			name:        "rounded up",
+			entropyBits: 17,
+
+			assertErr: require.NoError,
+			want:      passphrase.Passphrase{Text: "lusab-babad", Syllables: 2, Entropy: 32},
+		},
+		{
+			name:        "separator",
+			entropyBits: 48,
+			opts:        []passphrase.Option{passphrase.WithSeparator(" ")},
+
+			assertErr: require.NoError,
+			want:      passphrase.Passphrase{Text: "lusab babad zuzuz", Syllables: 3, Entropy: 48},
+		},
+		{
+			name:        "upper case",
+			entropyBits: 32,
+			opts:        []passphrase.Option{passphrase.WithCase(passphrase.Upper)},
+
+			assertErr: require.NoError,
+			want:      passphrase.Passphrase{Text: "LUSAB-BABAD", Syllables: 2, Entropy: 32},
+		},
+		{
+			name:        "title case",
+			entropyBits: 32,
+			opts:        []passphrase.Option{passphrase.WithCase(passphrase.Title), passphrase.WithSeparator("")},
+
+			assertErr: require.NoError,
+			want:      passphrase.Passphrase{Text: "LusabBabad", Syllables: 2, Entropy: 32},
+		},
+		{
+			name:        "invalid entropy",
+			entropyBits: 0,
+
+			assertErr: require.Error,
+		},
+		{
+			name:        "not enough random bytes",
+			entropyBits: 64,
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			opts := append([]passphrase.Option{passphrase.WithRandom(bytes.NewReader(random))}, test.opts...)
+
+			got, err := passphrase.Generate(test.entropyBits, opts...)
+			test.assertErr(t, err)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestGenerateRandom(t *testing.T) {
+	got, err := passphrase.Generate(80)
+	require.NoError(t, err)
+
+	require.Equal(t, 5, got.Syllables)
+	require.Len(t, got.Text, 5*5+4)
+
+	strength, err := passphrase.Check(got.Text)
+	require.NoError(t, err)
+	require.Equal(t, 5, strength.Syllables)
+}
+
+func TestCheck(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+
+		assertErr require.ErrorAssertionFunc
+		want      passphrase.Strength
+	}{
+		{
+			name: "single syllable",
+			in:   "lusab",
+
+			assertErr: require.NoError,
+			want:      passphrase.Strength{Syllables: 1, Entropy: 16, Score: passphrase.VeryWeak},
+		},
+		{
+			name: "any separator and case",
+			in:   "Lusab babad.GUTIH_tugil",
+
+			assertErr: require.NoError,
+			want:      passphrase.Strength{Syllables: 4, Entropy: 64, Score: passphrase.Strong},
+		},
+		{
+			name: "without separator",
+			in:   "lusabbabadgutihtugilhihuszugoz",
+
+			assertErr: require.NoError,
+			want:      passphrase.Strength{Syllables: 6, Entropy: 96, Score: passphrase.VeryStrong},
+		},
+		{
+			name: "repeated syllables",
+			in:   "lusab-lusab-lusab",
+
+			assertErr: require.NoError,
+			want: passphrase.Strength{
+				Syllables: 3,
+				Entropy:   16,
+				Score:     passphrase.VeryWeak,
+				Warnings:  []string{"2 repeated syllables"},
+			},
+		},
+		{
+			name: "repeated letters",
+			in:   "babab-zuzuz-lusab",
+
+			assertErr: require.NoError,
+			want: passphrase.Strength{
+				Syllables: 3,
+				Entropy:   28,
+				Score:     passphrase.VeryWeak,
+				Warnings:  []string{"2 syllables with repeated letters"},
+			},
+		},
+		{
+			name: "empty",
+			in:   "",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "incomplete syllable",
+			in:   "lusab-bab",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "invalid letter",
+			in:   "lusab-cabad",
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := passphrase.Check(test.in)
+			test.assertErr(t, err)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func ExampleCheck() {
+	strength, err := passphrase.Check("lusab-babad-gutih")
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Printf("%.0f bits, %s\n", strength.Entropy, strength.Score)
+	// Output: 48 bits, fair
+}
@@ -0,0 +1,144 @@
+package proquint
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IPA returns the pronunciation of the proquint in the International
+// Phonetic Alphabet (IPA). Syllables separated by hyphens are separated by a
+// space, syllables without a hyphen in between by a syllable break ('.').
+// Case is ignored, letters not used by proquints are kept as is.
+//
+//	lusab-babad -> lusɑb bɑbɑd
+func IPA(s string) string {
+	var res strings.Builder
+
+	for i, word := range pronunciationWords(s) {
+		if i > 0 {
+			res.WriteByte(' ')
+		}
+
+		for j, quint := range word {
+			if j > 0 {
+				res.WriteByte('.')
+			}
+
+			res.WriteString(ipaSyllable(quint))
+		}
+	}
+
+	return res.String()
+}
+
+type ssmlConfig struct {
+	pause        time.Duration
+	speakElement bool
+}
+
+type SSMLOption func(*ssmlConfig)
+
+// WithSSMLBreak sets the duration of the pause at hyphens. The default is
+// 250ms.
+func WithSSMLBreak(pause time.Duration) SSMLOption {
+	return func(cfg *ssmlConfig) {
+		cfg.pause = pause
+	}
+}
+
+// WithSSMLSpeakElement wraps the output in a <speak> root element, which
+// makes it a complete SSML document.
+func WithSSMLSpeakElement() SSMLOption {
+	return func(cfg *ssmlConfig) {
+		cfg.speakElement = true
+	}
+}
+
+// SSML returns the proquint as Speech Synthesis Markup Language (SSML) for
+// text-to-speech engines. Each syllable is emitted as phoneme element with
+// its IPA pronunciation and the hyphens are replaced with breaks:
+//
+//	<phoneme alphabet="ipa" ph="lusɑb">lusab</phoneme><break time="250ms"/><phoneme alphabet="ipa" ph="bɑbɑd">babad</phoneme>
+func SSML(s string, opts ...SSMLOption) string {
+	cfg := ssmlConfig{
+		pause: 250 * time.Millisecond,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	var res strings.Builder
+
+	if cfg.speakElement {
+		res.WriteString(`<speak>`)
+	}
+
+	for i, word := range pronunciationWords(s) {
+		if i > 0 {
+			fmt.Fprintf(&res, `<break time="%dms"/>`, cfg.pause.Milliseconds())
+		}
+
+		for _, quint := range word {
+			fmt.Fprintf(&res, `<phoneme alphabet="ipa" ph="%s">%s</phoneme>`, escapeXML(ipaSyllable(quint)), escapeXML(quint))
+		}
+	}
+
+	if cfg.speakElement {
+		res.WriteString(`</speak>`)
+	}
+
+	return res.String()
+}
+
+// pronunciationWords splits the proquint at the hyphens into words and each
+// word into syllables of 5 letters. The last syllable of a word may be
+// shorter.
+func pronunciationWords(s string) [][]string {
+	var words [][]string
+
+	for _, word := range strings.Split(strings.ToLower(s), "-") {
+		if word == "" {
+			continue
+		}
+
+		var quints []string
+		for len(word) > 5 {
+			quints = append(quints, word[:5])
+			word = word[5:]
+		}
+
+		words = append(words, append(quints, word))
+	}
+
+	return words
+}
+
+func ipaSyllable(quint string) string {
+	var res strings.Builder
+
+	for _, letter := range []byte(quint) {
+		ipa, ok := pronunciation[letter]
+		if !ok {
+			res.WriteByte(letter)
+			continue
+		}
+
+		res.WriteString(ipa)
+	}
+
+	return res.String()
+}
+
+var xmlEscaper = strings.NewReplacer(
+	`&`, "&amp;",
+	`<`, "&lt;",
+	`>`, "&gt;",
+	`"`, "&quot;",
+	`'`, "&apos;",
+)
+
+func escapeXML(s string) string {
+	return xmlEscaper.Replace(s)
+}
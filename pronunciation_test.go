@@ -0,0 +1,117 @@
+package proquint_test
+
+import (
+	"encoding/xml"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestIPA(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+
+		want string
+	}{
+		{
+			name: "empty",
+			in:   "",
+
+			want: "",
+		},
+		{
+			name: "all letters",
+			in:   "bafid-gohuj-kalim-nipor-sutav-zab",
+
+			want: "bɑfɪd ɡoʊhudʒ kɑlɪm nɪpoʊɹ sutɑv zɑb",
+		},
+		{
+			name: "without hyphens",
+			in:   "LUSABBABAD",
+
+			want: "lusɑb.bɑbɑd",
+		},
+		{
+			name: "final hyphen",
+			in:   "lusab-",
+
+			want: "lusɑb",
+		},
+		{
+			name: "unknown letters",
+			in:   "cyx",
+
+			want: "cyx",
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			require.Equal(t, test.want, proquint.IPA(test.in))
+		})
+	}
+}
+
+func TestSSML(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		opts []proquint.SSMLOption
+
+		want string
+	}{
+		{
+			name: "hyphens",
+			in:   "lusab-babad",
+
+			want: `<phoneme alphabet="ipa" ph="lusɑb">lusab</phoneme><break time="250ms"/><phoneme alphabet="ipa" ph="bɑbɑd">babad</phoneme>`,
+		},
+		{
+			name: "without hyphens",
+			in:   "lusabbabad",
+
+			want: `<phoneme alphabet="ipa" ph="lusɑb">lusab</phoneme><phoneme alphabet="ipa" ph="bɑbɑd">babad</phoneme>`,
+		},
+		{
+			name: "break and speak element",
+			in:   "lusab-bab",
+			opts: []proquint.SSMLOption{proquint.WithSSMLBreak(time.Second), proquint.WithSSMLSpeakElement()},
+
+			want: `<speak><phoneme alphabet="ipa" ph="lusɑb">lusab</phoneme><break time="1000ms"/><phoneme alphabet="ipa" ph="bɑb">bab</phoneme></speak>`,
+		},
+		{
+			name: "escaped",
+			in:   `<a&"'>`,
+
+			want: `<phoneme alphabet="ipa" ph="&lt;ɑ&amp;&quot;&apos;">&lt;a&amp;&quot;&apos;</phoneme><phoneme alphabet="ipa" ph="&gt;">&gt;</phoneme>`,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got := proquint.SSML(test.in, test.opts...)
+			require.Equal(t, test.want, got)
+
+			// The output is well-formed XML.
+			decoder := xml.NewDecoder(strings.NewReader("<root>" + got + "</root>"))
+			for {
+				_, err := decoder.Token()
+				if err != nil {
+					require.ErrorContains(t, err, "EOF")
+					break
+				}
+			}
+		})
+	}
+}
+
+func ExampleSSML() {
+	fmt.Println(proquint.SSML("kivaf-lusab", proquint.WithSSMLSpeakElement()))
+	// Output: <speak><phoneme alphabet="ipa" ph="kɪvɑf">kivaf</phoneme><break time="250ms"/><phoneme alphabet="ipa" ph="lusɑb">lusab</phoneme></speak>
+}
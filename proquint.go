@@ -12,3 +12,15 @@ var consonants = []byte{
 var vowel = []byte{
 	'a', 'i', 'o', 'u',
 }
+
+// pronunciation contains the pronunciation of the consonants and vowels in
+// the International Phonetic Alphabet (IPA). The vowels are pronounced as in
+// "fAther", "sIx", "slOw" and "fOOl".
+var pronunciation = map[byte]string{
+	'b': "b", 'd': "d", 'f': "f", 'g': "ɡ",
+	'h': "h", 'j': "dʒ", 'k': "k", 'l': "l",
+	'm': "m", 'n': "n", 'p': "p", 'r': "ɹ",
+	's': "s", 't': "t", 'v': "v", 'z': "z",
+
+	'a': "ɑ", 'i': "ɪ", 'o': "oʊ", 'u': "u",
+}
@@ -0,0 +1,77 @@
+package proquint
+
+import (
+	"crypto/hkdf"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/binary"
+	"fmt"
+	"strings"
+)
+
+// DefaultSASLabel is the default domain separation label for SAS.
+const DefaultSASLabel = "proquint-sas-v1"
+
+type sasConfig struct {
+	label string
+	opts  []EncodingOption
+}
+
+type SASOption func(*sasConfig)
+
+// WithSASLabel sets the domain separation label, which binds the short
+// authentication string to a protocol or purpose. Both devices must use the
+// same label. The default is DefaultSASLabel.
+func WithSASLabel(label string) SASOption {
+	return func(cfg *sasConfig) {
+		cfg.label = label
+	}
+}
+
+// WithSASEncodingOptions sets the options used to encode the short
+// authentication string.
+func WithSASEncodingOptions(opts ...EncodingOption) SASOption {
+	return func(cfg *sasConfig) {
+		cfg.opts = opts
+	}
+}
+
+// SAS derives a short authentication string (SAS) with the given number of
+// syllables from the shared secret and the transcript of a pairing session.
+// If both devices show the same SAS, they share the same secret and have
+// seen the same transcript. The SAS is derived with HKDF-SHA256, using the
+// length-prefixed label and the transcript as info. Use EqualSAS to compare
+// a SAS entered by the user.
+func SAS(secret, transcript []byte, syllables int, opts ...SASOption) (string, error) {
+	cfg := sasConfig{
+		label: DefaultSASLabel,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if syllables < 1 {
+		return "", fmt.Errorf("invalid number of syllables %d", syllables)
+	}
+
+	info := binary.BigEndian.AppendUint32(nil, uint32(len(cfg.label)))
+	info = append(info, cfg.label...)
+	info = append(info, transcript...)
+
+	key, err := hkdf.Key(sha256.New, secret, nil, string(info), syllables*2)
+	if err != nil {
+		return "", err
+	}
+
+	return FromBytes(key, cfg.opts...)
+}
+
+// EqualSAS reports, if the short authentication strings a and b are equal,
+// ignoring case and hyphens. The comparison is done in constant time.
+func EqualSAS(a, b string) bool {
+	a = strings.ToLower(strings.ReplaceAll(a, "-", ""))
+	b = strings.ToLower(strings.ReplaceAll(b, "-", ""))
+
+	return subtle.ConstantTimeCompare([]byte(a), []byte(b)) == 1
+}
@@ -0,0 +1,117 @@
+package proquint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestSAS(t *testing.T) {
+	tests := []struct {
+		name       string
+		secret     []byte
+		transcript []byte
+		syllables  int
+		opts       []proquint.SASOption
+
+		assertErr require.ErrorAssertionFunc
+		want      string
+	}{
+		{
+			name:       "default label",
+			secret:     []byte("secret"),
+			transcript: []byte("transcript"),
+			syllables:  3,
+
+			assertErr: require.NoError,
+			want:      "balih-lalog-vojup",
+		},
+		{
+			name:       "custom label",
+			secret:     []byte("secret"),
+			transcript: []byte("transcript"),
+			syllables:  3,
+			opts: []proquint.SASOption{
+				proquint.WithSASLabel("my-app pairing"),
+			},
+
+			assertErr: require.NoError,
+			want:      "furud-mitoz-nusug",
+		},
+		{
+			name:       "prefix of longer SAS",
+			secret:     []byte("secret"),
+			transcript: []byte("transcript"),
+			syllables:  2,
+
+			assertErr: require.NoError,
+			want:      "balih-lalog",
+		},
+		{
+			name:       "error - no syllables",
+			secret:     []byte("secret"),
+			transcript: []byte("transcript"),
+			syllables:  0,
+
+			assertErr: require.Error,
+		},
+		{
+			name:       "error - exceeds HKDF output",
+			secret:     []byte("secret"),
+			transcript: []byte("transcript"),
+			syllables:  255*16 + 1,
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			opts := append(tc.opts, proquint.WithSASEncodingOptions(proquint.WithHyphens()))
+
+			got, err := proquint.SAS(tc.secret, tc.transcript, tc.syllables, opts...)
+			tc.assertErr(t, err)
+
+			require.Equal(t, tc.want, got)
+		})
+	}
+}
+
+func TestSASDiffers(t *testing.T) {
+	sas, err := proquint.SAS([]byte("secret"), []byte("transcript"), 2)
+	require.NoError(t, err)
+
+	otherSecret, err := proquint.SAS([]byte("Secret"), []byte("transcript"), 2)
+	require.NoError(t, err)
+	require.NotEqual(t, sas, otherSecret)
+
+	otherTranscript, err := proquint.SAS([]byte("secret"), []byte("Transcript"), 2)
+	require.NoError(t, err)
+	require.NotEqual(t, sas, otherTranscript)
+
+	// The label is length-prefixed, moving bytes between label and
+	// transcript results in a different SAS.
+	shifted, err := proquint.SAS([]byte("secret"), []byte("1transcript"), 2, proquint.WithSASLabel("proquint-sas-v"))
+	require.NoError(t, err)
+	require.NotEqual(t, sas, shifted)
+}
+
+func TestEqualSAS(t *testing.T) {
+	require.True(t, proquint.EqualSAS("balih-lalog", "balihlalog"))
+	require.True(t, proquint.EqualSAS("BALIH-LALOG", "balih-lalog"))
+	require.False(t, proquint.EqualSAS("balih-lalog", "balih-lalot"))
+	require.False(t, proquint.EqualSAS("balih-lalog", "balih"))
+}
+
+func ExampleSAS() {
+	sas, _ := proquint.SAS([]byte("secret"), []byte("transcript"), 2, proquint.WithSASEncodingOptions(proquint.WithHyphens()))
+
+	fmt.Println(sas)
+	fmt.Println(proquint.EqualSAS(sas, "BALIH LALOG"), proquint.EqualSAS(sas, "balihlalog"))
+	// Output:
+	// balih-lalog
+	// false true
+}
@@ -0,0 +1,162 @@
+package proquint
+
+import (
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+// SpellingAlphabet is a spelling alphabet, which assigns a word to each
+// letter, used to spell proquints letter by letter, e.g. on a phone call
+// with poor voice quality.
+type SpellingAlphabet struct {
+	// Words maps each letter to its word.
+	Words map[byte]string
+
+	// Dash is the word for a hyphen.
+	Dash string
+
+	// Aliases maps additional words, which are accepted by ParseSpelling, to
+	// their letter, e.g. common misspellings.
+	Aliases map[string]byte
+}
+
+var (
+	// NATO is the NATO phonetic alphabet.
+	NATO = SpellingAlphabet{
+		Words: map[byte]string{
+			'a': "Alfa", 'b': "Bravo", 'c': "Charlie", 'd': "Delta",
+			'e': "Echo", 'f': "Foxtrot", 'g': "Golf", 'h': "Hotel",
+			'i': "India", 'j': "Juliett", 'k': "Kilo", 'l': "Lima",
+			'm': "Mike", 'n': "November", 'o': "Oscar", 'p': "Papa",
+			'q': "Quebec", 'r': "Romeo", 's': "Sierra", 't': "Tango",
+			'u': "Uniform", 'v': "Victor", 'w': "Whiskey", 'x': "X-ray",
+			'y': "Yankee", 'z': "Zulu",
+		},
+		Dash: "dash",
+		Aliases: map[string]byte{
+			"alpha":  'a',
+			"juliet": 'j',
+			"whisky": 'w',
+		},
+	}
+
+	// DIN5009 is the German spelling alphabet of DIN 5009:2022, based on
+	// the names of German cities.
+	DIN5009 = SpellingAlphabet{
+		Words: map[byte]string{
+			'a': "Aachen", 'b': "Berlin", 'c': "Chemnitz", 'd': "Düsseldorf",
+			'e': "Essen", 'f': "Frankfurt", 'g': "Goslar", 'h': "Hamburg",
+			'i': "Ingelheim", 'j': "Jena", 'k': "Köln", 'l': "Leipzig",
+			'm': "München", 'n': "Nürnberg", 'o': "Offenbach", 'p': "Potsdam",
+			'q': "Quickborn", 'r': "Rostock", 's': "Salzwedel", 't': "Tübingen",
+			'u': "Unna", 'v': "Völklingen", 'w': "Wuppertal", 'x': "Xanten",
+			'y': "Ypsilon", 'z': "Zwickau",
+		},
+		Dash: "Bindestrich",
+		Aliases: map[string]byte{
+			"duesseldorf": 'd',
+			"koeln":       'k',
+			"muenchen":    'm',
+			"nuernberg":   'n',
+			"tuebingen":   't',
+			"voelklingen": 'v',
+		},
+	}
+)
+
+// Spell spells the proquint letter by letter using the words of the
+// alphabet. Hyphens are spelled as the dash word of the alphabet, separated
+// by commas:
+//
+//	lusab-babad -> Lima Uniform Sierra Alfa Bravo, dash, Bravo Alfa Bravo Alfa Delta
+func Spell(s string, alphabet SpellingAlphabet) (string, error) {
+	var res strings.Builder
+
+	for i, word := range strings.Split(strings.ToLower(s), "-") {
+		if i > 0 {
+			res.WriteString(", " + alphabet.Dash)
+			if word != "" {
+				res.WriteString(", ")
+			}
+		}
+
+		for j, letter := range []byte(word) {
+			spelled, ok := alphabet.Words[letter]
+			if !ok {
+				return "", fmt.Errorf("letter %q is not part of the spelling alphabet", string([]byte{letter}))
+			}
+
+			if j > 0 {
+				res.WriteByte(' ')
+			}
+
+			res.WriteString(spelled)
+		}
+	}
+
+	return res.String(), nil
+}
+
+// ParseSpelling parses a spelled transcript, e.g. created by Spell, back to
+// the proquint. The words are separated by white space or punctuation and
+// matched ignoring case and diacritics (e.g. "Koln" matches "Köln"). Besides
+// the words and aliases of the alphabet, single letters are accepted. The
+// result is not validated to be a valid proquint.
+func ParseSpelling(transcript string, alphabet SpellingAlphabet) (string, error) {
+	letters := make(map[string]byte, len(alphabet.Words)+len(alphabet.Aliases))
+	for letter, word := range alphabet.Words {
+		letters[normalizeSpelling(word)] = letter
+	}
+
+	for alias, letter := range alphabet.Aliases {
+		letters[normalizeSpelling(alias)] = letter
+	}
+
+	dash := normalizeSpelling(alphabet.Dash)
+
+	tokens := strings.FieldsFunc(transcript, func(r rune) bool {
+		return unicode.IsSpace(r) || r == ',' || r == '.' || r == ';'
+	})
+
+	var res strings.Builder
+	for _, token := range tokens {
+		normalized := normalizeSpelling(token)
+
+		switch {
+		case normalized == "":
+		case normalized == dash:
+			res.WriteByte('-')
+		case len(normalized) == 1 && normalized[0] >= 'a' && normalized[0] <= 'z':
+			res.WriteString(normalized)
+		default:
+			letter, ok := letters[normalized]
+			if !ok {
+				return "", fmt.Errorf("unknown word %q in spelling", token)
+			}
+
+			res.WriteByte(letter)
+		}
+	}
+
+	return res.String(), nil
+}
+
+var diacritics = strings.NewReplacer(
+	"ä", "a", "ö", "o", "ü", "u", "ß", "ss",
+	"à", "a", "á", "a", "â", "a", "è", "e", "é", "e", "ê", "e",
+	"ì", "i", "í", "i", "î", "i", "ò", "o", "ó", "o", "ô", "o",
+	"ù", "u", "ú", "u", "û", "u", "ç", "c", "ñ", "n",
+)
+
+// normalizeSpelling returns the word in lower case without diacritics and
+// without any characters other than letters, e.g. "X-ray" -> "xray".
+func normalizeSpelling(word string) string {
+	return strings.Map(func(r rune) rune {
+		if r >= 'a' && r <= 'z' {
+			return r
+		}
+
+		return -1
+	}, diacritics.Replace(strings.ToLower(word)))
+}
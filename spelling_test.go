@@ -0,0 +1,152 @@
+package proquint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+var phoneticAlphabet = proquint.SpellingAlphabet{
+	Words: map[byte]string{
+		'a': "apple", 'b': "boy", 'd': "dog", 'l': "lucy", 's': "sugar", 'u': "uncle",
+	},
+	Dash: "minus",
+}
+
+func TestSpell(t *testing.T) {
+	tests := []struct {
+		name     string
+		in       string
+		alphabet proquint.SpellingAlphabet
+
+		assertErr require.ErrorAssertionFunc
+		want      string
+	}{
+		{
+			name:     "nato",
+			in:       "lusab-babad",
+			alphabet: proquint.NATO,
+
+			assertErr: require.NoError,
+			want:      "Lima Uniform Sierra Alfa Bravo, dash, Bravo Alfa Bravo Alfa Delta",
+		},
+		{
+			name:     "din 5009",
+			in:       "LUSAB-KIVAF",
+			alphabet: proquint.DIN5009,
+
+			assertErr: require.NoError,
+			want:      "Leipzig Unna Salzwedel Aachen Berlin, Bindestrich, Köln Ingelheim Völklingen Aachen Frankfurt",
+		},
+		{
+			name:     "custom",
+			in:       "lusab-bab-",
+			alphabet: phoneticAlphabet,
+
+			assertErr: require.NoError,
+			want:      "lucy uncle sugar apple boy, minus, boy apple boy, minus",
+		},
+		{
+			name:     "letter missing in alphabet",
+			in:       "kivaf",
+			alphabet: phoneticAlphabet,
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := proquint.Spell(test.in, test.alphabet)
+			test.assertErr(t, err)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestParseSpelling(t *testing.T) {
+	tests := []struct {
+		name       string
+		transcript string
+		alphabet   proquint.SpellingAlphabet
+
+		assertErr require.ErrorAssertionFunc
+		want      string
+	}{
+		{
+			name:       "nato",
+			transcript: "Lima Uniform Sierra Alfa Bravo, dash, Bravo Alfa Bravo Alfa Delta",
+			alphabet:   proquint.NATO,
+
+			assertErr: require.NoError,
+			want:      "lusab-babad",
+		},
+		{
+			name:       "nato aliases and letters",
+			transcript: "juliet alpha x-ray. b",
+			alphabet:   proquint.NATO,
+
+			assertErr: require.NoError,
+			want:      "jaxb",
+		},
+		{
+			name:       "din 5009 without diacritics",
+			transcript: "Koln Ingelheim Voelklingen Aachen Frankfurt Bindestrich muenchen",
+			alphabet:   proquint.DIN5009,
+
+			assertErr: require.NoError,
+			want:      "kivaf-m",
+		},
+		{
+			name:       "custom",
+			transcript: "LUCY uncle sugar apple boy minus",
+			alphabet:   phoneticAlphabet,
+
+			assertErr: require.NoError,
+			want:      "lusab-",
+		},
+		{
+			name:       "unknown word",
+			transcript: "Lima Uniform Sierra Alfa Brave",
+			alphabet:   proquint.NATO,
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			got, err := proquint.ParseSpelling(test.transcript, test.alphabet)
+			test.assertErr(t, err)
+
+			require.Equal(t, test.want, got)
+		})
+	}
+}
+
+func TestSpellRoundTrip(t *testing.T) {
+	for _, alphabet := range []proquint.SpellingAlphabet{proquint.NATO, proquint.DIN5009} {
+		for _, in := range []string{"lusab-babad", "bafid-gohuj-kalim-nipor-sutav-zab", "lusab-"} {
+			spelled, err := proquint.Spell(in, alphabet)
+			require.NoError(t, err)
+
+			got, err := proquint.ParseSpelling(spelled, alphabet)
+			require.NoError(t, err)
+			require.Equal(t, in, got)
+		}
+	}
+}
+
+func ExampleSpell() {
+	spelled, err := proquint.Spell("lusab-babad", proquint.NATO)
+	if err != nil {
+		panic(err)
+	}
+
+	fmt.Println(spelled)
+	// Output: Lima Uniform Sierra Alfa Bravo, dash, Bravo Alfa Bravo Alfa Delta
+}
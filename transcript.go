@@ -0,0 +1,366 @@
+package proquint
+
+import (
+	"bytes"
+	"cmp"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+)
+
+// TranscriptCandidate is a possible proquint for a transcript.
+type TranscriptCandidate struct {
+	// Quint is the proquint with hyphens.
+	Quint string
+
+	// Bytes is the proquint decoded with ToBytes.
+	Bytes []byte
+
+	// Score is the likelihood of the candidate, between 0 and 1. An exact
+	// match of the transcript has a score of 1.
+	Score float64
+}
+
+type transcriptConfig struct {
+	maxCandidates int
+	opts          []DecodingOption
+}
+
+type TranscriptOption func(*transcriptConfig)
+
+// WithMaxCandidates sets the maximum number of candidates returned by
+// ParseTranscript. The default is 5.
+func WithMaxCandidates(n int) TranscriptOption {
+	return func(cfg *transcriptConfig) {
+		cfg.maxCandidates = n
+	}
+}
+
+// WithTranscriptDecodingOptions sets the options passed to ToBytes to decode
+// the candidates. Candidates, which can not be decoded with these options,
+// are skipped.
+func WithTranscriptDecodingOptions(opts ...DecodingOption) TranscriptOption {
+	return func(cfg *transcriptConfig) {
+		cfg.opts = opts
+	}
+}
+
+// transcriptBeamWidth is the number of partial candidates kept while parsing
+// a transcript.
+const transcriptBeamWidth = 64
+
+// ParseTranscript parses the free-form text of a speech-to-text transcript,
+// e.g. "loo sab ba bad", and returns the most likely proquints, ordered by
+// descending score.
+//
+// The words of the transcript are mapped to proquint letters with a model of
+// the English spelling of the proquint sounds, e.g. "oo" is mapped to 'u'.
+// The letters of all words are concatenated and fitted to the consonant,
+// vowel, consonant, vowel, consonant structure of the proquint syllables,
+// which merges and splits words as necessary. Letters may be substituted
+// with similar sounding letters according to AcousticModel, letters which do
+// not fit are removed or missing letters are inserted. Each of these
+// corrections lowers the score of a candidate.
+func ParseTranscript(transcript string, opts ...TranscriptOption) ([]TranscriptCandidate, error) {
+	cfg := transcriptConfig{
+		maxCandidates: 5,
+	}
+
+	for _, opt := range opts {
+		opt(&cfg)
+	}
+
+	if cfg.maxCandidates < 1 {
+		return nil, fmt.Errorf("invalid maximum number of candidates %d", cfg.maxCandidates)
+	}
+
+	words := strings.FieldsFunc(strings.ToLower(transcript), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	})
+
+	beam := []fitState{{}}
+	for _, word := range words {
+		if word == "dash" || word == "hyphen" {
+			continue
+		}
+
+		var next []fitState
+		for _, s := range soundAlternatives(word) {
+			for _, state := range beam {
+				next = append(next, state.fit(s.letters, s.cost)...)
+			}
+		}
+
+		beam = pruneFitStates(next)
+	}
+
+	beam = completeFitStates(beam)
+
+	var candidates []TranscriptCandidate
+	for _, state := range beam {
+		if len(state.letters) == 0 {
+			continue
+		}
+
+		quint := hyphenate(state.letters)
+
+		res, err := ToBytes(quint, cfg.opts...)
+		if err != nil {
+			continue
+		}
+
+		candidates = append(candidates, TranscriptCandidate{
+			Quint: quint,
+			Bytes: res,
+			Score: math.Exp(-state.cost),
+		})
+
+		if len(candidates) == cfg.maxCandidates {
+			break
+		}
+	}
+
+	if len(candidates) == 0 {
+		return nil, fmt.Errorf("no proquint found in transcript %q", transcript)
+	}
+
+	return candidates, nil
+}
+
+// fitState is a partial candidate of ParseTranscript.
+type fitState struct {
+	letters string
+	cost    float64
+}
+
+// slot returns the table of letters expected next.
+func (s fitState) slot() []byte {
+	if len(s.letters)%5%2 == 1 {
+		return vowel
+	}
+
+	return consonants
+}
+
+// insertions returns the states with each letter of the next slot inserted.
+func (s fitState) insertions() []fitState {
+	var res []fitState
+	for _, letter := range s.slot() {
+		res = append(res, fitState{letters: s.letters + string(letter), cost: s.cost + 1})
+	}
+
+	return res
+}
+
+// fit returns the states after fitting the letters of a word to the
+// proquint structure.
+func (s fitState) fit(letters string, cost float64) []fitState {
+	beam := []fitState{{letters: s.letters, cost: s.cost + cost}}
+
+	for _, letter := range []byte(letters) {
+		var next []fitState
+		for _, state := range beam {
+			// Remove the letter.
+			next = append(next, fitState{letters: state.letters, cost: state.cost + 1})
+
+			if bytes.IndexByte(state.slot(), letter) < 0 {
+				// The letter does not fit, insert the missing letter before it.
+				for _, inserted := range state.insertions() {
+					if bytes.IndexByte(inserted.slot(), letter) >= 0 {
+						next = append(next, fitState{letters: inserted.letters + string(letter), cost: inserted.cost})
+					}
+				}
+
+				continue
+			}
+
+			// Keep the letter or substitute it with a letter, which differs in
+			// a single feature.
+			for _, candidate := range state.slot() {
+				substitution := AcousticModel.Substitution(letter, candidate)
+				if substitution > maxTranscriptSubstitution {
+					continue
+				}
+
+				next = append(next, fitState{letters: state.letters + string(candidate), cost: state.cost + substitution})
+			}
+		}
+
+		beam = pruneFitStates(next)
+	}
+
+	return beam
+}
+
+// maxTranscriptSubstitution is the maximum cost of a substitution, which
+// allows consonants differing in a single feature, e.g. b and p.
+const maxTranscriptSubstitution = 0.34
+
+// completeFitStates inserts the missing letters of incomplete final
+// syllables.
+func completeFitStates(beam []fitState) []fitState {
+	for range 4 {
+		var next []fitState
+		for _, state := range beam {
+			if len(state.letters)%5 == 0 {
+				next = append(next, state)
+				continue
+			}
+
+			next = append(next, state.insertions()...)
+		}
+
+		beam = pruneFitStates(next)
+	}
+
+	return beam
+}
+
+// pruneFitStates removes duplicate states, keeping the one with the lowest
+// cost, and keeps the best states ordered by cost.
+func pruneFitStates(states []fitState) []fitState {
+	slices.SortFunc(states, func(a, b fitState) int {
+		return cmp.Or(cmp.Compare(a.cost, b.cost), strings.Compare(a.letters, b.letters))
+	})
+
+	seen := make(map[string]bool, len(states))
+	res := states[:0]
+	for _, state := range states {
+		if seen[state.letters] {
+			continue
+		}
+
+		seen[state.letters] = true
+		res = append(res, state)
+
+		if len(res) == transcriptBeamWidth {
+			break
+		}
+	}
+
+	return res
+}
+
+// sound is a possible sequence of proquint letters for a spelling.
+type sound struct {
+	letters string
+	cost    float64
+}
+
+// soundRules map the English spelling of sounds to proquint letters. Letters
+// without a rule are kept as they are.
+var soundRules = []struct {
+	spelling     string
+	alternatives []sound
+}{
+	{spelling: "oo", alternatives: []sound{{letters: "u"}}},
+	{spelling: "ou", alternatives: []sound{{letters: "u"}, {letters: "o", cost: 0.3}}},
+	{spelling: "ee", alternatives: []sound{{letters: "i"}}},
+	{spelling: "ea", alternatives: []sound{{letters: "i"}}},
+	{spelling: "ie", alternatives: []sound{{letters: "i"}}},
+	{spelling: "ey", alternatives: []sound{{letters: "i", cost: 0.1}}},
+	{spelling: "ay", alternatives: []sound{{letters: "a", cost: 0.2}}},
+	{spelling: "oa", alternatives: []sound{{letters: "o"}}},
+	{spelling: "oe", alternatives: []sound{{letters: "o"}}},
+	{spelling: "ow", alternatives: []sound{{letters: "o"}}},
+	{spelling: "oh", alternatives: []sound{{letters: "o"}}},
+	{spelling: "ah", alternatives: []sound{{letters: "a"}}},
+	{spelling: "ph", alternatives: []sound{{letters: "f"}}},
+	{spelling: "ck", alternatives: []sound{{letters: "k"}}},
+	{spelling: "sh", alternatives: []sound{{letters: "s", cost: 0.3}}},
+	{spelling: "th", alternatives: []sound{{letters: "t", cost: 0.3}}},
+	{spelling: "ch", alternatives: []sound{{letters: "j", cost: 0.3}, {letters: "k", cost: 0.3}}},
+	{spelling: "c", alternatives: []sound{{letters: "k", cost: 0.1}, {letters: "s", cost: 0.2}}},
+	{spelling: "q", alternatives: []sound{{letters: "k", cost: 0.1}}},
+	{spelling: "x", alternatives: []sound{{letters: "ks", cost: 0.1}}},
+	{spelling: "w", alternatives: []sound{{letters: "v", cost: 0.5}, {letters: "u", cost: 0.5}}},
+	{spelling: "y", alternatives: []sound{{letters: "i", cost: 0.2}, {letters: "", cost: 0.5}, {letters: "j", cost: 0.5}}},
+	{spelling: "e", alternatives: []sound{{letters: "i", cost: 0.3}, {letters: "a", cost: 0.5}, {letters: "", cost: 0.5}}},
+}
+
+// maxSoundAlternatives is the maximum number of alternatives per word.
+const maxSoundAlternatives = 16
+
+// soundAlternatives returns the most likely proquint letters for the word
+// according to soundRules, ordered by cost.
+func soundAlternatives(word string) []sound {
+	// suffixes contains the alternatives of the suffixes of the word,
+	// indexed by their start position.
+	suffixes := make([][]sound, len(word)+1)
+	suffixes[len(word)] = []sound{{}}
+
+	for start := len(word) - 1; start >= 0; start-- {
+		if !utf8.RuneStart(word[start]) {
+			continue
+		}
+
+		var res []sound
+
+		add := func(prefix sound, length int) {
+			for _, rest := range suffixes[start+length] {
+				res = append(res, sound{letters: prefix.letters + rest.letters, cost: prefix.cost + rest.cost})
+			}
+		}
+
+		matched := false
+		for _, rule := range soundRules {
+			if !strings.HasPrefix(word[start:], rule.spelling) {
+				continue
+			}
+
+			for _, alternative := range rule.alternatives {
+				add(alternative, len(rule.spelling))
+			}
+
+			// Single letters without a proquint equivalent are always replaced.
+			matched = matched || len(rule.spelling) == 1
+		}
+
+		if !matched {
+			_, size := utf8.DecodeRuneInString(word[start:])
+			add(sound{letters: word[start : start+size]}, size)
+		}
+
+		suffixes[start] = bestSounds(res)
+	}
+
+	return suffixes[0]
+}
+
+// bestSounds removes duplicate sounds, keeping the one with the lowest cost,
+// and keeps the best sounds ordered by cost.
+func bestSounds(sounds []sound) []sound {
+	slices.SortStableFunc(sounds, func(a, b sound) int {
+		return cmp.Compare(a.cost, b.cost)
+	})
+
+	seen := make(map[string]bool, len(sounds))
+	res := sounds[:0]
+	for _, s := range sounds {
+		if seen[s.letters] {
+			continue
+		}
+
+		seen[s.letters] = true
+		res = append(res, s)
+
+		if len(res) == maxSoundAlternatives {
+			break
+		}
+	}
+
+	return res
+}
+
+// hyphenate separates the syllables of the letters with hyphens.
+func hyphenate(letters string) string {
+	quints := make([]string, 0, len(letters)/5)
+	for i := 0; i < len(letters); i += 5 {
+		quints = append(quints, letters[i:i+5])
+	}
+
+	return strings.Join(quints, "-")
+}
@@ -0,0 +1,158 @@
+package proquint_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+func TestParseTranscript(t *testing.T) {
+	tests := []struct {
+		name       string
+		transcript string
+		opts       []proquint.TranscriptOption
+
+		assertErr require.ErrorAssertionFunc
+		wantQuint string
+		wantBytes []byte
+		wantScore float64
+	}{
+		{
+			name:       "exact",
+			transcript: "LUSAB-BABAD",
+
+			assertErr: require.NoError,
+			wantQuint: "lusab-babad",
+			wantBytes: []byte{0x7F, 0x00, 0x00, 0x01},
+			wantScore: 1,
+		},
+		{
+			name:       "split syllables",
+			transcript: "loo sab ba bad",
+
+			assertErr: require.NoError,
+			wantQuint: "lusab-babad",
+			wantBytes: []byte{0x7F, 0x00, 0x00, 0x01},
+			wantScore: 1,
+		},
+		{
+			name:       "merged syllables",
+			transcript: "lusabbabad",
+
+			assertErr: require.NoError,
+			wantQuint: "lusab-babad",
+			wantBytes: []byte{0x7F, 0x00, 0x00, 0x01},
+			wantScore: 1,
+		},
+		{
+			name:       "spoken dash and punctuation",
+			transcript: "Lou sab, dash. Bah bad!",
+
+			assertErr: require.NoError,
+			wantQuint: "lusab-babad",
+			wantBytes: []byte{0x7F, 0x00, 0x00, 0x01},
+			wantScore: 1,
+		},
+		{
+			name:       "english words",
+			transcript: "lusab baby dad",
+
+			assertErr: require.NoError,
+			wantQuint: "lusab-babad",
+			wantBytes: []byte{0x7F, 0x00, 0x00, 0x01},
+			wantScore: 0.223,
+		},
+		{
+			name:       "english spelling",
+			transcript: "key vaf",
+
+			assertErr: require.NoError,
+			wantQuint: "kivaf",
+			wantBytes: []byte{0x67, 0x82},
+			wantScore: 0.905,
+		},
+		{
+			name:       "missing letter",
+			transcript: "lusa babad",
+
+			assertErr: require.NoError,
+			wantQuint: "lusab-babad",
+			wantBytes: []byte{0x7F, 0x00, 0x00, 0x01},
+			wantScore: 0.368,
+		},
+		{
+			name:       "decoding options",
+			transcript: "babad lusab babad",
+			opts:       []proquint.TranscriptOption{proquint.WithTranscriptDecodingOptions(proquint.WithDecodingTypeTag(1))},
+
+			assertErr: require.NoError,
+			wantQuint: "babad-lusab-babad",
+			wantBytes: []byte{0x7F, 0x00, 0x00, 0x01},
+			wantScore: 1,
+		},
+		{
+			name:       "no letters",
+			transcript: "- 42 -",
+
+			assertErr: require.Error,
+		},
+		{
+			name:       "invalid max candidates",
+			transcript: "lusab",
+			opts:       []proquint.TranscriptOption{proquint.WithMaxCandidates(0)},
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, test := range tests {
+		t.Run(test.name, func(t *testing.T) {
+			candidates, err := proquint.ParseTranscript(test.transcript, test.opts...)
+			test.assertErr(t, err)
+
+			if test.wantQuint == "" {
+				require.Empty(t, candidates)
+				return
+			}
+
+			require.NotEmpty(t, candidates)
+			require.LessOrEqual(t, len(candidates), 5)
+			require.Equal(t, test.wantQuint, candidates[0].Quint)
+			require.Equal(t, test.wantBytes, candidates[0].Bytes)
+			require.InDelta(t, test.wantScore, candidates[0].Score, 0.001)
+
+			for i := 1; i < len(candidates); i++ {
+				require.LessOrEqual(t, candidates[i].Score, candidates[i-1].Score)
+			}
+		})
+	}
+}
+
+func TestParseTranscriptMaxCandidates(t *testing.T) {
+	candidates, err := proquint.ParseTranscript("kivaf", proquint.WithMaxCandidates(2))
+	require.NoError(t, err)
+	require.Len(t, candidates, 2)
+
+	// Alternatives are similar sounding proquints.
+	require.Equal(t, "kivaf", candidates[0].Quint)
+	require.Less(t, candidates[1].Score, 1.0)
+	require.Less(t, proquint.Distance("kivaf", candidates[1].Quint, proquint.AcousticModel), 1.0)
+}
+
+func ExampleParseTranscript() {
+	candidates, err := proquint.ParseTranscript("loo sab ba bad", proquint.WithMaxCandidates(3))
+	if err != nil {
+		panic(err)
+	}
+
+	for _, candidate := range candidates {
+		fmt.Printf("%s %.2f %x\n", candidate.Quint, candidate.Score, candidate.Bytes)
+	}
+	// Output:
+	// lusab-babad 1.00 7f000001
+	// lufab-babad 0.85 7c800001
+	// losab-babad 0.78 7b000001
+}
@@ -0,0 +1,125 @@
+package proquint
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+)
+
+// Type is a type registered for type-tagged proquints. A type-tagged
+// proquint starts with a syllable containing the tag of the type, followed
+// by the syllables of the payload:
+//
+//	babaf-lusab-babad
+type Type struct {
+	// Tag is the value of the leading type-tag syllable.
+	Tag uint16
+
+	// Name is the human readable name of the type, e.g. "ipv4".
+	Name string
+
+	// Size is the size of the payload in bytes. A size of 0 allows payloads
+	// of any size.
+	Size int
+}
+
+var registry = struct {
+	sync.RWMutex
+
+	types map[uint16]Type
+}{
+	types: map[uint16]Type{},
+}
+
+// RegisterType registers a type for type-tagged proquints. The tag and the
+// name must be unique.
+func RegisterType(tag uint16, name string, size int) error {
+	if name == "" {
+		return fmt.Errorf("type name must not be empty")
+	}
+
+	if size < 0 {
+		return fmt.Errorf("invalid size %d for type %q", size, name)
+	}
+
+	registry.Lock()
+	defer registry.Unlock()
+
+	if t, ok := registry.types[tag]; ok {
+		return fmt.Errorf("type tag %d already registered for type %q", tag, t.Name)
+	}
+
+	for _, t := range registry.types {
+		if t.Name == name {
+			return fmt.Errorf("type %q already registered with tag %d", name, t.Tag)
+		}
+	}
+
+	registry.types[tag] = Type{
+		Tag:  tag,
+		Name: name,
+		Size: size,
+	}
+
+	return nil
+}
+
+// LookupType returns the type registered for the given tag.
+func LookupType(tag uint16) (Type, bool) {
+	registry.RLock()
+	defer registry.RUnlock()
+
+	t, ok := registry.types[tag]
+	return t, ok
+}
+
+// ToTagged decodes a type-tagged proquint string, created with the
+// WithTypeTag option. It returns the registered type of the leading
+// type-tag syllable and the decoded payload. The size of the payload is
+// validated against the size of the registered type.
+func ToTagged(in string, opts ...DecodingOption) (Type, []byte, error) {
+	quints := strings.ToLower(strings.ReplaceAll(in, "-", ""))
+	if len(quints) < 5 {
+		return Type{}, nil, fmt.Errorf("invalid input, type tag missing")
+	}
+
+	tag, err := decodeSyllable(quints[:5])
+	if err != nil {
+		return Type{}, nil, err
+	}
+
+	t, ok := LookupType(tag)
+	if !ok {
+		return Type{}, nil, fmt.Errorf("type tag %d is not registered", tag)
+	}
+
+	payload, err := ToBytes(in, append(opts[:len(opts):len(opts)], WithDecodingTypeTag(tag))...)
+	if err != nil {
+		return Type{}, nil, err
+	}
+
+	if t.Size > 0 && len(payload) != t.Size {
+		return Type{}, nil, fmt.Errorf("invalid payload size %d for type %q, expect %d", len(payload), t.Name, t.Size)
+	}
+
+	return t, payload, nil
+}
+
+// checkTypeTag verifies, that the quint contains the expected type tag.
+func checkTypeTag(quint string, tag uint16) error {
+	got, err := decodeSyllable(quint)
+	if err != nil {
+		return err
+	}
+
+	if got != tag {
+		name := fmt.Sprintf("%d", got)
+		if t, ok := LookupType(got); ok {
+			name = fmt.Sprintf("%d (%s)", got, t.Name)
+		}
+
+		return fmt.Errorf("type tag mismatch, expect %d, got %s", tag, name)
+	}
+
+	return nil
+}
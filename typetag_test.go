@@ -0,0 +1,178 @@
+package proquint_test
+
+import (
+	"fmt"
+	"net/netip"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/breml/proquint"
+)
+
+const (
+	tagTestIPv4   uint16 = 0x0001
+	tagTestUserID uint16 = 0x0002
+	tagTestBlob   uint16 = 0x0003
+)
+
+func init() {
+	for _, t := range []proquint.Type{
+		{Tag: tagTestIPv4, Name: "ipv4", Size: 4},
+		{Tag: tagTestUserID, Name: "user-id", Size: 4},
+		{Tag: tagTestBlob, Name: "blob"},
+	} {
+		err := proquint.RegisterType(t.Tag, t.Name, t.Size)
+		if err != nil {
+			panic(err)
+		}
+	}
+}
+
+func TestRegisterType(t *testing.T) {
+	require.Error(t, proquint.RegisterType(tagTestIPv4, "other", 4), "duplicate tag")
+	require.Error(t, proquint.RegisterType(0xF001, "ipv4", 4), "duplicate name")
+	require.Error(t, proquint.RegisterType(0xF001, "", 4), "empty name")
+	require.Error(t, proquint.RegisterType(0xF001, "negative", -1), "negative size")
+
+	typ, ok := proquint.LookupType(tagTestUserID)
+	require.True(t, ok)
+	require.Equal(t, proquint.Type{Tag: tagTestUserID, Name: "user-id", Size: 4}, typ)
+
+	_, ok = proquint.LookupType(0xF001)
+	require.False(t, ok)
+}
+
+func TestTypeTag(t *testing.T) {
+	hyphens := proquint.WithHyphens()
+
+	quint, err := proquint.FromBytes([]byte{127, 0, 0, 1}, hyphens, proquint.WithTypeTag(tagTestIPv4))
+	require.NoError(t, err)
+	require.Equal(t, "babad-lusab-babad", quint)
+
+	quint, err = proquint.FromBytes([]byte{}, hyphens, proquint.WithTypeTag(tagTestBlob))
+	require.NoError(t, err)
+	require.Equal(t, "babag", quint)
+
+	quint, err = proquint.FromBytes([]byte{1, 2, 3}, hyphens, proquint.WithTypeTag(tagTestBlob), proquint.WithEncodingPadding(proquint.HalfSyllablePadding))
+	require.NoError(t, err)
+	require.Equal(t, "babag-bahaf-bas", quint)
+
+	require.Equal(t, "babaf-lusab-babad", proquint.FromUint32(0x7F000001, hyphens, proquint.WithTypeTag(tagTestUserID)))
+	require.Equal(t, "babafbabab", proquint.FromUint16(0, proquint.WithTypeTag(tagTestUserID)))
+	require.Equal(t, "babag-babab-babab-babab-babab-babab-babab-babab-bagav", proquint.FromUint128(0, 0xCE, hyphens, proquint.WithTypeTag(tagTestBlob)))
+
+	got, err := proquint.ToBytes("babad-lusab-babad", proquint.WithDecodingTypeTag(tagTestIPv4))
+	require.NoError(t, err)
+	require.Equal(t, []byte{127, 0, 0, 1}, got)
+
+	_, err = proquint.ToBytes("babaf-lusab-babad", proquint.WithDecodingTypeTag(tagTestIPv4))
+	require.ErrorContains(t, err, "type tag mismatch")
+
+	_, err = proquint.ToBytes("", proquint.WithDecodingTypeTag(tagTestIPv4))
+	require.Error(t, err)
+
+	id, err := proquint.ToUint32("babaf-lusab-babad", proquint.WithDecodingTypeTag(tagTestUserID))
+	require.NoError(t, err)
+	require.Equal(t, uint32(0x7F000001), id)
+
+	_, err = proquint.ToUint32("babad-lusab-babad", proquint.WithDecodingTypeTag(tagTestUserID))
+	require.ErrorContains(t, err, "type tag mismatch")
+
+	_, err = proquint.ToUint32("lusab-babad", proquint.WithDecodingTypeTag(tagTestUserID))
+	require.Error(t, err)
+
+	hi, lo, err := proquint.ToUint128("babag-babab-babab-babab-babab-babab-babab-babab-bagav", proquint.WithDecodingTypeTag(tagTestBlob))
+	require.NoError(t, err)
+	require.Equal(t, uint64(0), hi)
+	require.Equal(t, uint64(0xCE), lo)
+}
+
+func TestToTagged(t *testing.T) {
+	tests := []struct {
+		name string
+		in   string
+		opts []proquint.DecodingOption
+
+		assertErr   require.ErrorAssertionFunc
+		wantType    proquint.Type
+		wantPayload []byte
+	}{
+		{
+			name: "ipv4",
+			in:   "babad-lusab-babad",
+
+			assertErr:   require.NoError,
+			wantType:    proquint.Type{Tag: tagTestIPv4, Name: "ipv4", Size: 4},
+			wantPayload: []byte{127, 0, 0, 1},
+		},
+		{
+			name: "blob with padding",
+			in:   "babag-bahaf-bas",
+			opts: []proquint.DecodingOption{
+				proquint.WithDecodingPadding(proquint.HalfSyllablePadding),
+			},
+
+			assertErr:   require.NoError,
+			wantType:    proquint.Type{Tag: tagTestBlob, Name: "blob"},
+			wantPayload: []byte{1, 2, 3},
+		},
+		{
+			name: "error - size mismatch",
+			in:   "babad-lusab",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "error - not registered",
+			in:   "zabad-lusab-babad",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "error - type tag missing",
+			in:   "",
+
+			assertErr: require.Error,
+		},
+		{
+			name: "error - invalid type tag",
+			in:   "baXad-lusab-babad",
+
+			assertErr: require.Error,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			typ, payload, err := proquint.ToTagged(tc.in, tc.opts...)
+			tc.assertErr(t, err)
+
+			require.Equal(t, tc.wantType, typ)
+			require.Equal(t, tc.wantPayload, payload)
+		})
+	}
+}
+
+func TestToTaggedKeepsOptions(t *testing.T) {
+	// Spare capacity of the options of the caller must not be overwritten.
+	opts := make([]proquint.DecodingOption, 1, 2)
+	opts[0] = proquint.WithDecodingPadding(proquint.HalfSyllablePadding)
+
+	_, _, err := proquint.ToTagged("babad-lusab-babad", opts...)
+	require.NoError(t, err)
+	require.Nil(t, opts[:2][1])
+}
+
+func ExampleToTagged() {
+	addr := netip.MustParseAddr("127.0.0.1").As4()
+	quint, _ := proquint.FromBytes(addr[:], proquint.WithHyphens(), proquint.WithTypeTag(tagTestIPv4))
+	fmt.Println(quint)
+
+	typ, payload, _ := proquint.ToTagged(quint)
+	addrFromPayload, _ := netip.AddrFromSlice(payload)
+	fmt.Println(typ.Name, addrFromPayload)
+	// Output:
+	// babad-lusab-babad
+	// ipv4 127.0.0.1
+}